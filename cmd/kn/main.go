@@ -18,13 +18,17 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
 
+	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/kn/plugin"
 	"knative.dev/client/pkg/kn/root"
@@ -53,6 +57,18 @@ func run(args []string) error {
 		return err
 	}
 
+	// Create kn root command and all sub-commands
+	rootCmd, err := root.NewRootCommand()
+	if err != nil {
+		return err
+	}
+
+	// Expand an alias given as first command
+	args, err = expandAlias(rootCmd, args)
+	if err != nil {
+		return err
+	}
+
 	// Strip of all flags to get the non-flag commands only
 	commands, err := stripFlags(args)
 	if err != nil {
@@ -66,12 +82,6 @@ func run(args []string) error {
 		return err
 	}
 
-	// Create kn root command and all sub-commands
-	rootCmd, err := root.NewRootCommand()
-	if err != nil {
-		return err
-	}
-
 	if plugin != nil {
 		// Validate & Execute plugin
 		err = validatePlugin(rootCmd, plugin)
@@ -79,18 +89,127 @@ func run(args []string) error {
 			return err
 		}
 
-		return plugin.Execute(argsWithoutCommands(args, plugin.CommandParts()))
+		env, err := pluginContext(args, plugin).Environ()
+		if err != nil {
+			return err
+		}
+		// Default flags are given first so that the plugin can override them
+		defaultFlags := config.GlobalConfig.DefaultFlags()[strings.Join(plugin.CommandParts(), " ")]
+		pluginArgs := append(append([]string{}, defaultFlags...), argsWithoutCommands(args, plugin.CommandParts())...)
+		return plugin.Execute(pluginArgs, env)
 	} else {
+		if cmd, _, err := rootCmd.Find(args); err == nil && cmd != rootCmd {
+			args = addDefaultFlags(cmd, args)
+		}
+
 		// Validate args for root command
-		err = validateRootCommand(rootCmd)
+		err = validateRootCommand(rootCmd, args)
 		if err != nil {
 			return err
 		}
-		// Execute kn root command, args are taken from os.Args directly
+		// Execute kn root command with the expanded args
+		rootCmd.SetArgs(args)
 		return rootCmd.Execute()
 	}
 }
 
+// Replace the first command with the expansion of the alias with this name.
+// Aliases can't override built-in commands.
+func expandAlias(rootCmd *cobra.Command, args []string) ([]string, error) {
+	commands, err := stripFlags(args)
+	if err != nil || len(commands) == 0 {
+		return args, err
+	}
+	expansion, ok := config.GlobalConfig.Aliases()[commands[0]]
+	if !ok {
+		return args, nil
+	}
+	if cmd, _, err := rootCmd.Find(commands[:1]); err == nil && cmd != rootCmd {
+		return args, nil
+	}
+	for i, arg := range args {
+		if arg == commands[0] {
+			expanded := append([]string{}, args[:i]...)
+			expanded = append(expanded, expansion...)
+			return append(expanded, args[i+1:]...), nil
+		}
+	}
+	return args, nil
+}
+
+// Add the default flags configured for the command. Defaults for flags which are
+// given on the command line, also in their negated --no- form, are skipped.
+func addDefaultFlags(cmd *cobra.Command, args []string) []string {
+	defaultFlags := config.GlobalConfig.DefaultFlags()[strings.Join(strings.Fields(cmd.CommandPath())[1:], " ")]
+	if len(defaultFlags) == 0 {
+		return args
+	}
+
+	given := map[string]bool{}
+	for i := 0; i < len(args) && args[i] != "--"; i++ {
+		name, takesValue := lookupFlag(cmd, args[i])
+		if name != "" {
+			given[name] = true
+		}
+		if takesValue {
+			i++
+		}
+	}
+
+	var toAdd []string
+	for i := 0; i < len(defaultFlags); i++ {
+		flagArgs := defaultFlags[i : i+1]
+		name, takesValue := lookupFlag(cmd, defaultFlags[i])
+		if takesValue && i+1 < len(defaultFlags) {
+			flagArgs = defaultFlags[i : i+2]
+			i++
+		}
+		if name == "" || !given[name] {
+			toAdd = append(toAdd, flagArgs...)
+		}
+	}
+
+	// Flags must come before a "--" separator
+	ret := []string{}
+	for i, arg := range args {
+		if arg == "--" {
+			ret = append(ret, toAdd...)
+			return append(ret, args[i:]...)
+		}
+		ret = append(ret, arg)
+	}
+	return append(ret, toAdd...)
+}
+
+// Lookup the flag given as argument for a command. Returns the name of the flag
+// without a "no-" prefix and whether the flag's value is given as separate
+// argument. The name is empty if the argument is not a known flag.
+func lookupFlag(cmd *cobra.Command, arg string) (string, bool) {
+	var flag *pflag.Flag
+	var inlineValue bool
+	switch {
+	case strings.HasPrefix(arg, "--") && len(arg) > 2:
+		name := arg[2:]
+		if idx := strings.Index(name, "="); idx >= 0 {
+			name, inlineValue = name[:idx], true
+		}
+		flag = cmd.Flags().Lookup(name)
+		if flag == nil {
+			flag = cmd.InheritedFlags().Lookup(name)
+		}
+	case strings.HasPrefix(arg, "-") && !strings.HasPrefix(arg, "--") && len(arg) > 1:
+		inlineValue = len(arg) > 2
+		flag = cmd.Flags().ShorthandLookup(arg[1:2])
+		if flag == nil {
+			flag = cmd.InheritedFlags().ShorthandLookup(arg[1:2])
+		}
+	}
+	if flag == nil {
+		return "", false
+	}
+	return strings.TrimPrefix(flag.Name, "no-"), !inlineValue && flag.NoOptDefVal == ""
+}
+
 // Get only the args provided but no options. The extraction
 // process is a bit tricky as Cobra doesn't provide such
 // functionality out of the box
@@ -133,6 +252,35 @@ func stripFlags(args []string) ([]string, error) {
 	return *commandsFound, nil
 }
 
+// Resolve the global options given on the command line and the configuration
+// for handing them over to a plugin
+func pluginContext(args []string, pl plugin.Plugin) plugin.Context {
+	flags := pflag.NewFlagSet("kn", pflag.ContinueOnError)
+	flags.ParseErrorsWhitelist = pflag.ParseErrorsWhitelist{UnknownFlags: true}
+	flags.Usage = func() {}
+	kubeConfig := flags.String("kubeconfig", "", "")
+	namespace := flags.StringP("namespace", "n", "", "")
+	// Errors are ignored as the arguments are parsed by the plugin, too
+	flags.Parse(filterHelpOptions(args))
+
+	params := &commands.KnParams{KubeCfgPath: *kubeConfig}
+	if *namespace == "" {
+		*namespace, _ = params.CurrentNamespace()
+	}
+	kubeContext, _ := params.CurrentContext()
+	if *kubeConfig == "" {
+		*kubeConfig = strings.Join(clientcmd.NewDefaultClientConfigLoadingRules().GetLoadingPrecedence(), string(filepath.ListSeparator))
+	}
+	return plugin.Context{
+		ConfigFile:   config.GlobalConfig.ConfigFile(),
+		KubeConfig:   *kubeConfig,
+		Context:      kubeContext,
+		Namespace:    *namespace,
+		PluginsDir:   config.GlobalConfig.PluginsDir(),
+		PluginConfig: config.GlobalConfig.PluginConfig(plugin.ConfigName(pl)),
+	}
+}
+
 // Strip all plugin commands before calling out to the plugin
 func argsWithoutCommands(cmdArgs []string, pluginCommandsParts []string) []string {
 	var ret []string
@@ -174,8 +322,8 @@ func validatePlugin(root *cobra.Command, plugin plugin.Plugin) error {
 // Check whether an unknown sub-command is addressed and return an error if this is the case
 // Needs to be called after the plugin has been extracted (as a plugin name can also lead to
 // an unknown sub command error otherwise)
-func validateRootCommand(cmd *cobra.Command) error {
-	foundCmd, innerArgs, err := cmd.Find(os.Args[1:])
+func validateRootCommand(cmd *cobra.Command, args []string) error {
+	foundCmd, innerArgs, err := cmd.Find(args)
 	if err == nil && foundCmd.HasSubCommands() && len(innerArgs) > 0 {
 		argsWithoutFlags, err := stripFlags(innerArgs)
 		if len(argsWithoutFlags) > 0 || err != nil {
@@ -17,7 +17,9 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -25,6 +27,8 @@ import (
 	"gotest.tools/assert"
 
 	"knative.dev/client/lib/test"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
 	"knative.dev/client/pkg/kn/root"
 	"knative.dev/client/pkg/util"
 )
@@ -95,11 +99,12 @@ func TestValidatePlugin(t *testing.T) {
 // Used above for wrapping the command part to check
 type commandPartsOnlyPlugin []string
 
-func (f commandPartsOnlyPlugin) CommandParts() []string       { return f }
-func (f commandPartsOnlyPlugin) Name() string                 { return "" }
-func (f commandPartsOnlyPlugin) Execute(args []string) error  { return nil }
-func (f commandPartsOnlyPlugin) Description() (string, error) { return "", nil }
-func (f commandPartsOnlyPlugin) Path() string                 { return "pluginPath" }
+func (f commandPartsOnlyPlugin) CommandParts() []string                    { return f }
+func (f commandPartsOnlyPlugin) Name() string                              { return "" }
+func (f commandPartsOnlyPlugin) Execute(args []string, env []string) error { return nil }
+func (f commandPartsOnlyPlugin) Description() (string, error)              { return "", nil }
+func (f commandPartsOnlyPlugin) Path() string                              { return "pluginPath" }
+func (f commandPartsOnlyPlugin) Metadata() (*plugin.Metadata, error)       { return nil, nil }
 
 func TestArgsWithoutCommands(t *testing.T) {
 	data := []struct {
@@ -129,6 +134,69 @@ func TestArgsWithoutCommands(t *testing.T) {
 	}
 }
 
+func TestPluginContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kn-plugin-context")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+	kubeConfig := filepath.Join(dir, "kubeconfig")
+	err = ioutil.WriteFile(kubeConfig, []byte(`
+apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://localhost:8443
+contexts:
+- name: test
+  context:
+    cluster: test
+    namespace: from-kubeconfig
+current-context: test
+`), 0600)
+	assert.NilError(t, err)
+	oldKubeConfig, hadKubeConfig := os.LookupEnv("KUBECONFIG")
+	os.Setenv("KUBECONFIG", kubeConfig)
+	defer func() {
+		if hadKubeConfig {
+			os.Setenv("KUBECONFIG", oldKubeConfig)
+		} else {
+			os.Unsetenv("KUBECONFIG")
+		}
+	}()
+
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{
+		TestConfigFile:    "/kn/config.yaml",
+		TestPluginsDir:    "/kn/plugins",
+		TestPluginConfigs: map[string]map[string]interface{}{"source-kafka": {"bootstrap": "kafka:9092"}},
+	}
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	pl := commandPartsOnlyPlugin{"source", "kafka"}
+	ctx := pluginContext([]string{"source", "kafka", "create", "--topic", "foo"}, pl)
+	assert.DeepEqual(t, ctx, plugin.Context{
+		ConfigFile:   "/kn/config.yaml",
+		KubeConfig:   kubeConfig,
+		Context:      "test",
+		Namespace:    "from-kubeconfig",
+		PluginsDir:   "/kn/plugins",
+		PluginConfig: map[string]interface{}{"bootstrap": "kafka:9092"},
+	})
+
+	ctx = pluginContext([]string{"source", "kafka", "-n", "ns", "--kubeconfig", kubeConfig, "--help"}, pl)
+	assert.Equal(t, ctx.Namespace, "ns")
+	assert.Equal(t, ctx.KubeConfig, kubeConfig)
+
+	ctx = pluginContext([]string{"hello"}, commandPartsOnlyPlugin{"hello"})
+	assert.Assert(t, ctx.PluginConfig == nil)
+
+	// The context and namespace of the selected profile are handed over
+	config.GlobalConfig = &config.TestConfig{TestProfile: &config.Profile{Context: "staging", Namespace: "team"}}
+	ctx = pluginContext([]string{"source", "kafka"}, pl)
+	assert.Equal(t, ctx.Context, "staging")
+	assert.Equal(t, ctx.Namespace, "team")
+}
+
 func TestUnknownCommands(t *testing.T) {
 	oldArgs := os.Args
 	defer (func() {
@@ -161,7 +229,7 @@ func TestUnknownCommands(t *testing.T) {
 		rootCmd, err := root.NewRootCommand()
 		os.Args = args
 		assert.NilError(t, err)
-		err = validateRootCommand(rootCmd)
+		err = validateRootCommand(rootCmd, d.givenCmdArgs)
 		if len(d.expectedError) == 0 {
 			assert.NilError(t, err)
 			continue
@@ -177,6 +245,93 @@ func TestUnknownCommands(t *testing.T) {
 
 }
 
+func TestExpandAlias(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	config.GlobalConfig = &config.TestConfig{TestAliases: map[string][]string{
+		"deploy":  {"service", "update", "--no-wait"},
+		"service": {"version"},
+	}}
+	rootCmd, err := root.NewRootCommand()
+	assert.NilError(t, err)
+
+	for _, d := range []struct {
+		given    []string
+		expected []string
+	}{
+		{[]string{"deploy", "foo", "--image", "bar"}, []string{"service", "update", "--no-wait", "foo", "--image", "bar"}},
+		{[]string{"-n", "ns", "deploy", "foo"}, []string{"-n", "ns", "service", "update", "--no-wait", "foo"}},
+		{[]string{"--help"}, []string{"--help"}},
+		{[]string{"hello", "deploy"}, []string{"hello", "deploy"}},
+		// Built-in commands can't be overridden
+		{[]string{"service", "list"}, []string{"service", "list"}},
+	} {
+		args, err := expandAlias(rootCmd, d.given)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, args, d.expected)
+	}
+}
+
+func TestAddDefaultFlags(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	config.GlobalConfig = &config.TestConfig{TestDefaultFlags: map[string][]string{
+		"service create": {"--no-wait", "--label", "team=x", "-n", "team-ns", "--no-lock-to-digest"},
+	}}
+	rootCmd, err := root.NewRootCommand()
+	assert.NilError(t, err)
+
+	for _, d := range []struct {
+		args     []string
+		expected []string
+	}{
+		{
+			[]string{"service", "create", "foo", "--image", "bar"},
+			[]string{"service", "create", "foo", "--image", "bar", "--no-wait", "--label", "team=x", "-n", "team-ns", "--no-lock-to-digest"},
+		},
+		{
+			// Explicit flags have precedence, also if negated
+			[]string{"service", "create", "--wait", "foo", "--namespace=ns", "-l", "a=b", "--lock-to-digest"},
+			[]string{"service", "create", "--wait", "foo", "--namespace=ns", "-l", "a=b", "--lock-to-digest"},
+		},
+		{
+			[]string{"service", "create", "foo", "--", "arg"},
+			[]string{"service", "create", "foo", "--no-wait", "--label", "team=x", "-n", "team-ns", "--no-lock-to-digest", "--", "arg"},
+		},
+		{
+			[]string{"service", "update", "foo"},
+			[]string{"service", "update", "foo"},
+		},
+	} {
+		cmd, _, err := rootCmd.Find(d.args)
+		assert.NilError(t, err)
+		args := addDefaultFlags(cmd, d.args)
+		assert.DeepEqual(t, args, d.expected)
+	}
+}
+
+func TestRunWithDefaultFlags(t *testing.T) {
+	oldArgs := os.Args
+	oldConfig := config.GlobalConfig
+	defer func() {
+		os.Args = oldArgs
+		config.GlobalConfig = oldConfig
+	}()
+	os.Args = []string{"kn", "--config", "/no/config/please.yaml", "v"}
+	assert.NilError(t, config.BootstrapConfig())
+	config.GlobalConfig = &config.TestConfig{
+		TestAliases:      map[string][]string{"v": {"version"}},
+		TestDefaultFlags: map[string][]string{"version": {"-o", "yaml"}},
+	}
+
+	capture := test.CaptureOutput(t)
+	err := run(os.Args[1:])
+	out, _ := capture.Close()
+
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "GitRevision:", "SupportedAPIs:"))
+}
+
 func TestStripFlags(t *testing.T) {
 
 	data := []struct {
@@ -15,12 +15,16 @@
 package dynamic
 
 import (
+	"fmt"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 
+	knerrors "knative.dev/client/pkg/errors"
 	"knative.dev/client/pkg/util"
 )
 
@@ -48,6 +52,18 @@ type KnDynamicClient interface {
 	// ListSources returns list of available source objects
 	ListSources(types ...WithType) (*unstructured.UnstructuredList, error)
 
+	// GetSourceType returns the CRD of the source type given as kind or resource name
+	GetSourceType(sourceType string) (*unstructured.Unstructured, error)
+
+	// CreateSource creates the given source object of the given type
+	CreateSource(sourceType string, source *unstructured.Unstructured) error
+
+	// GetSource returns the source object of the given type and name
+	GetSource(sourceType string, name string) (*unstructured.Unstructured, error)
+
+	// DeleteSource deletes the source object of the given type and name
+	DeleteSource(sourceType string, name string) error
+
 	// RawClient returns the raw dynamic client interface
 	RawClient() dynamic.Interface
 }
@@ -153,3 +169,91 @@ func (c *knDynamicClient) ListSources(types ...WithType) (*unstructured.Unstruct
 	}
 	return &sourceList, nil
 }
+
+// GetSource returns the source object of the given type and name. The type can
+// be given as kind (e.g. 'PingSource'), as plural resource name
+// (e.g. 'pingsources') or as qualified resource name (e.g. 'pingsources.sources.knative.dev')
+func (c *knDynamicClient) GetSource(sourceType string, name string) (*unstructured.Unstructured, error) {
+	gvr, err := c.sourceGVR(sourceType)
+	if err != nil {
+		return nil, err
+	}
+	source, err := c.client.Resource(gvr).Namespace(c.namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	return source, nil
+}
+
+// DeleteSource deletes the source object of the given type and name. See
+// GetSource for how the type can be specified.
+func (c *knDynamicClient) DeleteSource(sourceType string, name string) error {
+	gvr, err := c.sourceGVR(sourceType)
+	if err != nil {
+		return err
+	}
+	err = c.client.Resource(gvr).Namespace(c.namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// GetSourceType returns the CRD of the installed source type matching the
+// given kind or resource name. See GetSource for how the type can be specified.
+func (c *knDynamicClient) GetSourceType(sourceType string) (*unstructured.Unstructured, error) {
+	sourceTypes, err := c.ListSourcesTypes()
+	if err != nil {
+		return nil, err
+	}
+	for _, source := range sourceTypes.Items {
+		kind, err := kindFromUnstructured(&source)
+		if err != nil {
+			return nil, err
+		}
+		gvr, err := gvrFromUnstructured(&source)
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(sourceType, kind) ||
+			strings.EqualFold(sourceType, gvr.Resource) ||
+			strings.EqualFold(sourceType, gvr.Resource+"."+gvr.Group) {
+			return source.DeepCopy(), nil
+		}
+	}
+	return nil, fmt.Errorf("no source type '%s' found, use 'kn source list-types' to list the available source types", sourceType)
+}
+
+// CreateSource creates the given source object of the given type. The
+// apiVersion and kind of the source are set from the source type's CRD.
+func (c *knDynamicClient) CreateSource(sourceType string, source *unstructured.Unstructured) error {
+	crd, err := c.GetSourceType(sourceType)
+	if err != nil {
+		return err
+	}
+	gvr, err := gvrFromUnstructured(crd)
+	if err != nil {
+		return err
+	}
+	kind, err := kindFromUnstructured(crd)
+	if err != nil {
+		return err
+	}
+	source.SetAPIVersion(gvr.GroupVersion().String())
+	source.SetKind(kind)
+	_, err = c.client.Resource(gvr).Namespace(c.namespace).Create(source, metav1.CreateOptions{})
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// sourceGVR looks up the GVR of the installed source type matching the given
+// kind or resource name
+func (c *knDynamicClient) sourceGVR(sourceType string) (schema.GroupVersionResource, error) {
+	crd, err := c.GetSourceType(sourceType)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	return gvrFromUnstructured(crd)
+}
@@ -118,6 +118,81 @@ func TestListSources(t *testing.T) {
 	})
 }
 
+func TestGetSource(t *testing.T) {
+	client := createFakeKnDynamicClient(testNamespace,
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+		newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource"),
+	)
+
+	for _, sourceType := range []string{"PingSource", "pingsource", "pingsources", "pingsources.sources.knative.dev"} {
+		source, err := client.GetSource(sourceType, "p1")
+		assert.NilError(t, err)
+		assert.Equal(t, source.GetName(), "p1")
+		assert.Equal(t, source.GetKind(), "PingSource")
+	}
+
+	_, err := client.GetSource("PingSource", "p2")
+	assert.ErrorContains(t, err, "p2")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = client.GetSource("ApiServerSource", "p1")
+	assert.ErrorContains(t, err, "no source type 'ApiServerSource' found")
+}
+
+func TestGetSourceType(t *testing.T) {
+	client := createFakeKnDynamicClient(testNamespace,
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+	)
+	crd, err := client.GetSourceType("pingsources")
+	assert.NilError(t, err)
+	assert.Equal(t, crd.GetName(), "pingsources")
+
+	_, err = client.GetSourceType("KafkaSource")
+	assert.ErrorContains(t, err, "no source type 'KafkaSource' found")
+}
+
+func TestCreateSource(t *testing.T) {
+	client := createFakeKnDynamicClient(testNamespace,
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+	)
+
+	source := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	source.SetName("p1")
+	source.SetNamespace(testNamespace)
+	err := client.CreateSource("pingsource", source)
+	assert.NilError(t, err)
+
+	created, err := client.GetSource("PingSource", "p1")
+	assert.NilError(t, err)
+	assert.Equal(t, created.GetAPIVersion(), "sources.knative.dev/v1alpha1")
+	assert.Equal(t, created.GetKind(), "PingSource")
+
+	err = client.CreateSource("pingsource", source)
+	assert.ErrorContains(t, err, "already exists")
+
+	err = client.CreateSource("KafkaSource", source)
+	assert.ErrorContains(t, err, "no source type")
+}
+
+func TestDeleteSource(t *testing.T) {
+	client := createFakeKnDynamicClient(testNamespace,
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+		newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource"),
+	)
+
+	err := client.DeleteSource("PingSource", "p1")
+	assert.NilError(t, err)
+
+	_, err = client.GetSource("PingSource", "p1")
+	assert.ErrorContains(t, err, "not found")
+
+	err = client.DeleteSource("PingSource", "p1")
+	assert.ErrorContains(t, err, "not found")
+
+	err = client.DeleteSource("ApiServerSource", "a1")
+	assert.ErrorContains(t, err, "no source type")
+}
+
 // createFakeKnDynamicClient gives you a dynamic client for testing containing the given objects.
 // See also the one in the fake package. Duplicated here to avoid a dependency loop.
 func createFakeKnDynamicClient(testNamespace string, objects ...runtime.Object) KnDynamicClient {
@@ -0,0 +1,269 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamic
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// SpecField describes a single field of a source's spec as declared in the
+// OpenAPI v3 schema of the source CRD
+type SpecField struct {
+	// Path of the field below .spec, with the segments separated by '.'
+	Path string
+	// Type of the field, e.g. 'string' or '[]integer'
+	Type string
+	// Required is true if the field must be set when its parent is given
+	Required bool
+	// Description as given in the schema
+	Description string
+}
+
+// SpecSchema is the OpenAPI v3 schema of the spec of a source type
+type SpecSchema struct {
+	schema map[string]interface{}
+}
+
+// SpecSchemaFromUnstructured extracts the schema of .spec from a source CRD.
+// The schema of the served version is preferred over the CRD wide validation
+// schema. A nil SpecSchema is returned if the CRD doesn't carry a schema.
+func SpecSchemaFromUnstructured(crd *unstructured.Unstructured) (*SpecSchema, error) {
+	content := crd.UnstructuredContent()
+	var schema map[string]interface{}
+
+	versions, _, _ := unstructured.NestedSlice(content, "spec", "versions")
+	for _, v := range versions {
+		vmap, ok := v.(map[string]interface{})
+		if !ok || vmap["served"] != true {
+			continue
+		}
+		if s, found, _ := unstructured.NestedMap(vmap, "schema", "openAPIV3Schema"); found {
+			schema = s
+			break
+		}
+	}
+	if schema == nil {
+		if s, found, _ := unstructured.NestedMap(content, "spec", "validation", "openAPIV3Schema"); found {
+			schema = s
+		}
+	}
+	if schema == nil {
+		return nil, nil
+	}
+
+	spec, found, err := unstructured.NestedMap(schema, "properties", "spec")
+	if err != nil || !found {
+		return nil, fmt.Errorf("can't find spec in schema of source CRD %s: %v", crd.GetName(), err)
+	}
+	return &SpecSchema{schema: spec}, nil
+}
+
+// Description returns the description of the spec as given in the schema
+func (s *SpecSchema) Description() string {
+	description, _ := s.schema["description"].(string)
+	return description
+}
+
+// Fields returns all fields of the spec sorted by their path. Nested objects
+// are flattened, their fields having a path with multiple segments.
+func (s *SpecSchema) Fields() []SpecField {
+	var fields []SpecField
+	collectFields(s.schema, "", &fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+	return fields
+}
+
+func collectFields(schema map[string]interface{}, prefix string, fields *[]SpecField) {
+	properties, _ := schema["properties"].(map[string]interface{})
+	required := requiredFields(schema)
+	for name, p := range properties {
+		property, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		if _, nested := property["properties"]; nested {
+			collectFields(property, path, fields)
+			continue
+		}
+		description, _ := property["description"].(string)
+		*fields = append(*fields, SpecField{
+			Path:        path,
+			Type:        typeString(property),
+			Required:    required[name],
+			Description: description,
+		})
+	}
+}
+
+// Set coerces the value to the type declared in the schema for the given field
+// path and sets it in the spec. Fields unknown to the schema are rejected.
+func (s *SpecSchema) Set(spec map[string]interface{}, path string, value string) error {
+	segments := strings.Split(path, ".")
+	schema := s.schema
+	current := spec
+	for i, segment := range segments {
+		if segment == "" {
+			return fmt.Errorf("invalid spec field '%s'", path)
+		}
+		fieldSchema, err := propertySchema(schema, segment)
+		if err != nil {
+			return fmt.Errorf("unknown spec field '%s'", path)
+		}
+		if i == len(segments)-1 {
+			coerced, err := coerce(fieldSchema, value)
+			if err != nil {
+				return fmt.Errorf("invalid value '%s' for spec field '%s': %v", value, path, err)
+			}
+			current[segment] = coerced
+			return nil
+		}
+		if t, _ := fieldSchema["type"].(string); t != "" && t != "object" {
+			return fmt.Errorf("spec field '%s' is of type %s and has no field '%s'", strings.Join(segments[:i+1], "."), typeString(fieldSchema), segments[i+1])
+		}
+		next, ok := current[segment].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[segment] = next
+		}
+		schema = fieldSchema
+		current = next
+	}
+	return nil
+}
+
+// Validate checks that all required fields are set in the spec. Required
+// fields of nested objects are only checked if the object is given.
+func (s *SpecSchema) Validate(spec map[string]interface{}) error {
+	var missing []string
+	validateRequired(s.schema, spec, "", &missing)
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing required spec field(s): %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func validateRequired(schema map[string]interface{}, object map[string]interface{}, prefix string, missing *[]string) {
+	properties, _ := schema["properties"].(map[string]interface{})
+	for name := range requiredFields(schema) {
+		if _, found := object[name]; !found {
+			*missing = append(*missing, prefix+name)
+		}
+	}
+	for name, value := range object {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if property, ok := properties[name].(map[string]interface{}); ok {
+			validateRequired(property, nested, prefix+name+".", missing)
+		}
+	}
+}
+
+// propertySchema returns the schema of the named property. Objects which
+// only declare additionalProperties (i.e. maps) accept any property name.
+func propertySchema(schema map[string]interface{}, name string) (map[string]interface{}, error) {
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		if property, ok := properties[name].(map[string]interface{}); ok {
+			return property, nil
+		}
+	}
+	switch additional := schema["additionalProperties"].(type) {
+	case map[string]interface{}:
+		return additional, nil
+	case bool:
+		if additional {
+			return map[string]interface{}{}, nil
+		}
+	}
+	if preserve, _ := schema["x-kubernetes-preserve-unknown-fields"].(bool); preserve {
+		return map[string]interface{}{}, nil
+	}
+	return nil, fmt.Errorf("unknown field %s", name)
+}
+
+func coerce(schema map[string]interface{}, value string) (interface{}, error) {
+	t, _ := schema["type"].(string)
+	switch t {
+	case "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		var ret []interface{}
+		for _, v := range strings.Split(value, ",") {
+			item, err := coerce(items, v)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, item)
+		}
+		return ret, nil
+	case "object":
+		return nil, fmt.Errorf("expected a value for a field of this object, not for the object itself")
+	default:
+		if enum, ok := schema["enum"].([]interface{}); ok {
+			for _, e := range enum {
+				if e == value {
+					return value, nil
+				}
+			}
+			return nil, fmt.Errorf("must be one of %v", enum)
+		}
+		return value, nil
+	}
+}
+
+func typeString(schema map[string]interface{}) string {
+	t, _ := schema["type"].(string)
+	switch t {
+	case "":
+		return "any"
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		return "[]" + typeString(items)
+	case "object":
+		if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+			return "map[string]" + typeString(additional)
+		}
+	}
+	return t
+}
+
+func requiredFields(schema map[string]interface{}) map[string]bool {
+	ret := map[string]bool{}
+	required, _ := schema["required"].([]interface{})
+	for _, r := range required {
+		if name, ok := r.(string); ok {
+			ret[name] = true
+		}
+	}
+	return ret
+}
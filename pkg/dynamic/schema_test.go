@@ -0,0 +1,171 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamic
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSpecSchemaFromUnstructured(t *testing.T) {
+	crd := newSourceCRDObjWithSchema("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource")
+	schema, err := SpecSchemaFromUnstructured(crd)
+	assert.NilError(t, err)
+	assert.Assert(t, schema != nil)
+	assert.Equal(t, schema.Description(), "KafkaSourceSpec defines the desired state")
+
+	// Schema in .spec.validation
+	crd = newSourceCRDObjWithSpec("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource")
+	unstructured.SetNestedMap(crd.Object, kafkaSourceSchema(), "spec", "validation", "openAPIV3Schema")
+	schema, err = SpecSchemaFromUnstructured(crd)
+	assert.NilError(t, err)
+	assert.Assert(t, schema != nil)
+
+	// No schema at all
+	schema, err = SpecSchemaFromUnstructured(newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"))
+	assert.NilError(t, err)
+	assert.Assert(t, schema == nil)
+
+	// Schema without spec
+	crd = newSourceCRDObjWithSpec("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource")
+	unstructured.SetNestedMap(crd.Object, map[string]interface{}{"type": "object"}, "spec", "validation", "openAPIV3Schema")
+	_, err = SpecSchemaFromUnstructured(crd)
+	assert.ErrorContains(t, err, "can't find spec")
+}
+
+func TestSpecSchemaFields(t *testing.T) {
+	schema, err := SpecSchemaFromUnstructured(newSourceCRDObjWithSchema("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"))
+	assert.NilError(t, err)
+	fields := schema.Fields()
+	assert.DeepEqual(t, fields, []SpecField{
+		{Path: "bootstrapServers", Type: "[]string", Required: true, Description: "Bootstrap servers"},
+		{Path: "ceOverrides.extensions", Type: "map[string]string"},
+		{Path: "consumerGroup", Type: "string"},
+		{Path: "net.tls.enable", Type: "boolean"},
+		{Path: "replicas", Type: "integer"},
+		{Path: "sink", Type: "object"},
+		{Path: "topics", Type: "[]string", Required: true},
+	})
+}
+
+func TestSpecSchemaSet(t *testing.T) {
+	schema, err := SpecSchemaFromUnstructured(newSourceCRDObjWithSchema("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"))
+	assert.NilError(t, err)
+
+	spec := map[string]interface{}{}
+	assert.NilError(t, schema.Set(spec, "bootstrapServers", "a:9092,b:9092"))
+	assert.NilError(t, schema.Set(spec, "replicas", "3"))
+	assert.NilError(t, schema.Set(spec, "net.tls.enable", "true"))
+	assert.NilError(t, schema.Set(spec, "ceOverrides.extensions.foo", "bar"))
+	assert.DeepEqual(t, spec, map[string]interface{}{
+		"bootstrapServers": []interface{}{"a:9092", "b:9092"},
+		"replicas":         int64(3),
+		"net": map[string]interface{}{
+			"tls": map[string]interface{}{"enable": true},
+		},
+		"ceOverrides": map[string]interface{}{
+			"extensions": map[string]interface{}{"foo": "bar"},
+		},
+	})
+
+	err = schema.Set(spec, "replicas", "many")
+	assert.ErrorContains(t, err, "invalid value 'many' for spec field 'replicas'")
+	err = schema.Set(spec, "topic", "foo")
+	assert.ErrorContains(t, err, "unknown spec field 'topic'")
+	err = schema.Set(spec, "consumerGroup.name", "foo")
+	assert.ErrorContains(t, err, "is of type string")
+	err = schema.Set(spec, "net", "foo")
+	assert.ErrorContains(t, err, "not for the object itself")
+	err = schema.Set(spec, "net..tls", "foo")
+	assert.ErrorContains(t, err, "invalid spec field")
+}
+
+func TestSpecSchemaValidate(t *testing.T) {
+	schema, err := SpecSchemaFromUnstructured(newSourceCRDObjWithSchema("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"))
+	assert.NilError(t, err)
+
+	spec := map[string]interface{}{}
+	assert.ErrorContains(t, schema.Validate(spec), "missing required spec field(s): bootstrapServers, topics")
+
+	assert.NilError(t, schema.Set(spec, "bootstrapServers", "a:9092"))
+	assert.NilError(t, schema.Set(spec, "topics", "t1"))
+	assert.NilError(t, schema.Validate(spec))
+}
+
+func newSourceCRDObjWithSchema(name, group, version, kind string) *unstructured.Unstructured {
+	crd := newSourceCRDObjWithSpec(name, group, version, kind)
+	unstructured.SetNestedSlice(crd.Object, []interface{}{
+		map[string]interface{}{
+			"name":   version,
+			"served": true,
+			"schema": map[string]interface{}{
+				"openAPIV3Schema": kafkaSourceSchema(),
+			},
+		},
+	}, "spec", "versions")
+	return crd
+}
+
+func kafkaSourceSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"type":        "object",
+				"description": "KafkaSourceSpec defines the desired state",
+				"required":    []interface{}{"bootstrapServers", "topics"},
+				"properties": map[string]interface{}{
+					"bootstrapServers": map[string]interface{}{
+						"type":        "array",
+						"description": "Bootstrap servers",
+						"items":       map[string]interface{}{"type": "string"},
+					},
+					"topics": map[string]interface{}{
+						"type":  "array",
+						"items": map[string]interface{}{"type": "string"},
+					},
+					"consumerGroup": map[string]interface{}{"type": "string"},
+					"replicas":      map[string]interface{}{"type": "integer"},
+					"net": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"tls": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"enable": map[string]interface{}{"type": "boolean"},
+								},
+							},
+						},
+					},
+					"ceOverrides": map[string]interface{}{
+						"type": "object",
+						"properties": map[string]interface{}{
+							"extensions": map[string]interface{}{
+								"type":                 "object",
+								"additionalProperties": map[string]interface{}{"type": "string"},
+							},
+						},
+					},
+					"sink": map[string]interface{}{
+						"type":                                 "object",
+						"x-kubernetes-preserve-unknown-fields": true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewChannelCommand to group channel commands
+func NewChannelCommand(p *commands.KnParams) *cobra.Command {
+	channelCmd := &cobra.Command{
+		Use:     "channel",
+		Short:   "Manage event channels",
+		Aliases: []string{"channels"},
+	}
+	channelCmd.AddCommand(NewChannelCreateCommand(p))
+	channelCmd.AddCommand(NewChannelDescribeCommand(p))
+	channelCmd.AddCommand(NewChannelDeleteCommand(p))
+	channelCmd.AddCommand(NewChannelListCommand(p))
+	return channelCmd
+}
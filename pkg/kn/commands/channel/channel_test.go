@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"bytes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+)
+
+// Helper methods
+var blankConfig clientcmd.ClientConfig
+
+func init() {
+	var err error
+	blankConfig, err = clientcmd.NewClientConfigFromBytes([]byte(`kind: Config
+version: v1
+users:
+- name: u
+clusters:
+- name: c
+  cluster:
+    server: example.com
+contexts:
+- name: x
+  context:
+    user: u
+    cluster: c
+current-context: x
+`))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func executeChannelCommand(messagingClient knmessagingv1beta1.KnMessagingClient, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewMessagingClient = func(namespace string) (knmessagingv1beta1.KnMessagingClient, error) {
+		return messagingClient, nil
+	}
+
+	cmd := NewChannelCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func createChannel(name string, typeMeta *metav1.TypeMeta) *v1beta1.Channel {
+	return knmessagingv1beta1.NewChannelBuilder(name).Namespace("default").Type(typeMeta).Build()
+}
+
+func createReadyChannel(name string, typeMeta *metav1.TypeMeta) *v1beta1.Channel {
+	channel := createChannel(name, typeMeta)
+	channel.Status.Address = &duckv1.Addressable{URL: &apis.URL{Scheme: "http", Host: name + "-kn-channel.default.svc.cluster.local"}}
+	channel.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: "True"}}
+	return channel
+}
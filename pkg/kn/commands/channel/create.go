@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+)
+
+var createExample = `
+  # Create a channel 'pipe' with default setting for channel configuration
+  kn channel create pipe
+
+  # Create a channel 'imc1' of type InMemoryChannel using inbuilt 'imc' alias to 'messaging.knative.dev:v1beta1:InMemoryChannel'
+  kn channel create imc1 --type imc
+
+  # Create a channel 'k1' with KafkaChannel
+  kn channel create k1 --type messaging.knative.dev:v1alpha1:KafkaChannel`
+
+// NewChannelCreateCommand is for creating a Channel
+func NewChannelCreateCommand(p *commands.KnParams) *cobra.Command {
+	var typeFlags ChannelTypeFlags
+
+	cmd := &cobra.Command{
+		Use:     "create NAME",
+		Short:   "Create a channel",
+		Example: createExample,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("'channel create' requires the channel name given as single argument")
+			}
+			name := args[0]
+
+			channelType, err := typeFlags.Parse()
+			if err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			messagingClient, err := p.NewMessagingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			channel := knmessagingv1beta1.NewChannelBuilder(name).
+				Namespace(namespace).
+				Type(channelType).
+				Build()
+
+			err = messagingClient.ChannelsClient().CreateChannel(channel)
+			if err != nil {
+				return fmt.Errorf(
+					"cannot create channel '%s' in namespace '%s' "+
+						"because: %s", name, namespace, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Channel '%s' created in namespace '%s'.\n", name, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	typeFlags.Add(cmd.Flags())
+	return cmd
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestCreateChannelErrorCase(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+
+	_, err := executeChannelCommand(cClient, "create")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeChannelCommand(cClient, "create", "pipe", "--type", "invalid")
+	assert.ErrorContains(t, err, "invalid channel type")
+	assert.ErrorContains(t, err, "Group:Version:Kind")
+}
+
+func TestCreateChannelDefaultType(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+	cRecorder.CreateChannel(createChannel("pipe", nil), nil)
+
+	out, err := executeChannelCommand(cClient, "create", "pipe")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Channel", "pipe", "created", "default"))
+
+	cRecorder.Validate()
+}
+
+func TestCreateChannelWithType(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+
+	imc := &metav1.TypeMeta{APIVersion: "messaging.knative.dev/v1beta1", Kind: "InMemoryChannel"}
+	cRecorder.CreateChannel(createChannel("pipe", imc), nil)
+	out, err := executeChannelCommand(cClient, "create", "pipe", "--type", "imc")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Channel", "pipe", "created"))
+
+	kafka := &metav1.TypeMeta{APIVersion: "messaging.knative.dev/v1alpha1", Kind: "KafkaChannel"}
+	cRecorder.CreateChannel(createChannel("k1", kafka), nil)
+	out, err = executeChannelCommand(cClient, "create", "k1", "--type", "messaging.knative.dev:v1alpha1:KafkaChannel")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Channel", "k1", "created"))
+
+	cRecorder.Validate()
+}
+
+func TestCreateChannelError(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+	cRecorder.CreateChannel(createChannel("pipe", nil), fmt.Errorf("boom"))
+
+	_, err := executeChannelCommand(cClient, "create", "pipe")
+	assert.ErrorContains(t, err, "cannot create channel 'pipe'")
+	assert.ErrorContains(t, err, "boom")
+
+	cRecorder.Validate()
+}
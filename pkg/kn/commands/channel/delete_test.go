@@ -0,0 +1,53 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDeleteChannel(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+	cRecorder.DeleteChannel("pipe", nil)
+
+	out, err := executeChannelCommand(cClient, "delete", "pipe")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Channel", "pipe", "deleted", "default"))
+
+	cRecorder.Validate()
+}
+
+func TestDeleteChannelErrorCase(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+	cRecorder.DeleteChannel("pipe", fmt.Errorf("channel pipe not found"))
+
+	_, err := executeChannelCommand(cClient, "delete")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeChannelCommand(cClient, "delete", "pipe")
+	assert.ErrorContains(t, err, "not found")
+
+	cRecorder.Validate()
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/printers"
+)
+
+var describeExample = `
+  # Describe a channel 'pipe'
+  kn channel describe pipe`
+
+// NewChannelDescribeCommand returns a new command for describing a Channel
+func NewChannelDescribeCommand(p *commands.KnParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "describe NAME",
+		Short:   "Show details of a channel",
+		Example: describeExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'channel describe' requires the channel name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			messagingClient, err := p.NewMessagingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			channel, err := messagingClient.ChannelsClient().GetChannel(name)
+			if err != nil {
+				return err
+			}
+			return describeChannel(cmd.OutOrStdout(), channel, false)
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	return cmd
+}
+
+func describeChannel(out io.Writer, channel *v1beta1.Channel, printDetails bool) error {
+	dw := printers.NewPrefixWriter(out)
+	commands.WriteMetadata(dw, &channel.ObjectMeta, printDetails)
+	dw.WriteAttribute("Type", channelTypeString(channel))
+	if channel.Status.Address != nil && channel.Status.Address.URL != nil {
+		dw.WriteAttribute("URL", channel.Status.Address.URL.String())
+	}
+	if channel.Status.Channel != nil {
+		backing := dw.WriteAttribute("Backing Channel", "")
+		backing.WriteAttribute("Kind", channel.Status.Channel.Kind)
+		backing.WriteAttribute("Name", channel.Status.Channel.Name)
+		backing.WriteAttribute("APIVersion", channel.Status.Channel.APIVersion)
+	}
+	if len(channel.Status.Subscribers) > 0 {
+		subscribers := dw.WriteAttribute("Subscribers", "")
+		for _, subscriber := range channel.Status.Subscribers {
+			subscribers.WriteAttribute(string(subscriber.UID), fmt.Sprintf("%s %s", subscriber.Ready, subscriber.Message))
+		}
+	}
+	dw.WriteLine()
+	commands.WriteConditions(dw, channel.Status.Conditions, printDetails)
+	if err := dw.Flush(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// channelTypeString returns the kind of the channel CRD backing the channel
+func channelTypeString(channel *v1beta1.Channel) string {
+	if channel.Status.Channel != nil && channel.Status.Channel.Kind != "" {
+		return channel.Status.Channel.Kind
+	}
+	if channel.Spec.ChannelTemplate != nil {
+		return channel.Spec.ChannelTemplate.Kind
+	}
+	return ""
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	"gotest.tools/assert/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDescribeChannel(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+
+	channel := createReadyChannel("pipe", &metav1.TypeMeta{APIVersion: "messaging.knative.dev/v1beta1", Kind: "InMemoryChannel"})
+	channel.Status.Channel = &duckv1.KReference{APIVersion: "messaging.knative.dev/v1beta1", Kind: "InMemoryChannel", Name: "pipe"}
+	channel.Status.Subscribers = []eventingduckv1beta1.SubscriberStatus{{UID: "1234", Ready: "True"}}
+	cRecorder.GetChannel("pipe", channel, nil)
+
+	out, err := executeChannelCommand(cClient, "describe", "pipe")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Regexp("Name:\\s+pipe", out))
+	assert.Assert(t, cmp.Regexp("Type:\\s+InMemoryChannel", out))
+	assert.Assert(t, util.ContainsAll(out, "URL:", "http://pipe-kn-channel.default.svc.cluster.local"))
+	assert.Assert(t, util.ContainsAll(out, "Backing Channel:", "Kind:", "APIVersion:"))
+	assert.Assert(t, util.ContainsAll(out, "Subscribers:", "1234", "True"))
+	assert.Assert(t, util.ContainsAll(out, "Conditions:", "Ready"))
+
+	cRecorder.Validate()
+}
+
+func TestDescribeChannelErrorCase(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+	cRecorder.GetChannel("pipe", nil, fmt.Errorf("channel pipe not found"))
+
+	_, err := executeChannelCommand(cClient, "describe")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeChannelCommand(cClient, "describe", "pipe")
+	assert.ErrorContains(t, err, "not found")
+
+	cRecorder.Validate()
+}
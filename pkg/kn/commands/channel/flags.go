@@ -0,0 +1,65 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// channelTypes are the short names which can be used with --type
+var channelTypes = map[string]metav1.TypeMeta{
+	"imc": {
+		APIVersion: "messaging.knative.dev/v1beta1",
+		Kind:       "InMemoryChannel",
+	},
+}
+
+// ChannelTypeFlags holds the channel type given with --type
+type ChannelTypeFlags struct {
+	Type string
+}
+
+// Add adds the --type flag to the given flagset
+func (c *ChannelTypeFlags) Add(f *pflag.FlagSet) {
+	f.StringVar(&c.Type, "type", "",
+		"Override channel type to create, in the format '--type Group:Version:Kind'. "+
+			"If flag is not specified, the default channel type of the cluster or namespace is used. "+
+			"Predefined aliases: 'imc' for InMemoryChannel.")
+}
+
+// Parse returns the channel template type for the given --type value,
+// or nil if no type has been given
+func (c *ChannelTypeFlags) Parse() (*metav1.TypeMeta, error) {
+	if c.Type == "" {
+		return nil, nil
+	}
+	if typeMeta, ok := channelTypes[c.Type]; ok {
+		return &typeMeta, nil
+	}
+	parts := strings.Split(c.Type, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid channel type '%s', use either 'imc' or the format 'Group:Version:Kind', e.g. 'messaging.knative.dev:v1beta1:InMemoryChannel'", c.Type)
+	}
+	return &metav1.TypeMeta{
+		APIVersion: parts[0] + "/" + parts[1],
+		Kind:       parts[2],
+	}, nil
+}
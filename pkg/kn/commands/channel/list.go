@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	hprinters "knative.dev/client/pkg/printers"
+)
+
+var listExample = `
+  # List all channels
+  kn channel list
+
+  # List channels in YAML format
+  kn channel list -o yaml`
+
+// NewChannelListCommand is for listing channel objects
+func NewChannelListCommand(p *commands.KnParams) *cobra.Command {
+	channelListFlags := flags.NewListPrintFlags(ListHandlers)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List channels",
+		Aliases: []string{"ls"},
+		Example: listExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			messagingClient, err := p.NewMessagingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			channelList, err := messagingClient.ChannelsClient().ListChannel()
+			if err != nil {
+				return err
+			}
+			if len(channelList.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No channels found.\n")
+				return nil
+			}
+
+			// empty namespace indicates all-namespaces flag is specified
+			if namespace == "" {
+				channelListFlags.EnsureWithNamespace()
+			}
+
+			return channelListFlags.Print(channelList, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), true)
+	channelListFlags.AddFlags(cmd)
+	return cmd
+}
+
+// ListHandlers adds print handlers for channel list command
+func ListHandlers(h hprinters.PrintHandler) {
+	channelColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Namespace", Type: "string", Description: "Namespace of the Channel instance", Priority: 0},
+		{Name: "Name", Type: "string", Description: "Name of the Channel instance", Priority: 1},
+		{Name: "Type", Type: "string", Description: "Type of the Channel instance", Priority: 1},
+		{Name: "URL", Type: "string", Description: "URL of the Channel instance", Priority: 1},
+		{Name: "Age", Type: "string", Description: "Age of the Channel instance", Priority: 1},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the Channel instance", Priority: 1},
+		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+	}
+	h.TableHandler(channelColumnDefinitions, printChannel)
+	h.TableHandler(channelColumnDefinitions, printChannelList)
+}
+
+func printChannelList(channelList *v1beta1.ChannelList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(channelList.Items))
+	for i := range channelList.Items {
+		r, err := printChannel(&channelList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printChannel(channel *v1beta1.Channel, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	name := channel.Name
+	ctype := channelTypeString(channel)
+	url := ""
+	if channel.Status.Address != nil && channel.Status.Address.URL != nil {
+		url = channel.Status.Address.URL.String()
+	}
+	age := commands.TranslateTimestampSince(channel.CreationTimestamp)
+	ready := commands.ReadyCondition(channel.Status.Conditions)
+	reason := commands.NonReadyConditionReason(channel.Status.Conditions)
+
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: channel},
+	}
+
+	if options.AllNamespaces {
+		row.Cells = append(row.Cells, channel.Namespace)
+	}
+
+	row.Cells = append(row.Cells, name, ctype, url, age, ready, reason)
+	return []metav1beta1.TableRow{row}, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestChannelList(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+
+	imc := &metav1.TypeMeta{APIVersion: "messaging.knative.dev/v1beta1", Kind: "InMemoryChannel"}
+	channelList := &v1beta1.ChannelList{Items: []v1beta1.Channel{
+		*createReadyChannel("c1", imc),
+		*createChannel("c2", nil),
+	}}
+	cRecorder.ListChannel(channelList, nil)
+
+	out, err := executeChannelCommand(cClient, "list")
+	assert.NilError(t, err)
+	lines := strings.Split(out, "\n")
+	assert.Check(t, util.ContainsAll(lines[0], "NAME", "TYPE", "URL", "AGE", "READY", "REASON"))
+	assert.Check(t, util.ContainsAll(lines[1], "c1", "InMemoryChannel", "http://c1-kn-channel.default.svc.cluster.local", "True"))
+	assert.Check(t, util.ContainsAll(lines[2], "c2"))
+
+	cRecorder.Validate()
+}
+
+func TestChannelListAllNamespaces(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+	cRecorder.ListChannel(&v1beta1.ChannelList{Items: []v1beta1.Channel{*createChannel("c1", nil)}}, nil)
+
+	out, err := executeChannelCommand(cClient, "list", "--all-namespaces")
+	assert.NilError(t, err)
+	lines := strings.Split(out, "\n")
+	assert.Check(t, util.ContainsAll(lines[0], "NAMESPACE", "NAME"))
+	assert.Check(t, util.ContainsAll(lines[1], "default", "c1"))
+
+	cRecorder.Validate()
+}
+
+func TestChannelListEmpty(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.ChannelsRecorder()
+	cRecorder.ListChannel(&v1beta1.ChannelList{}, nil)
+
+	out, err := executeChannelCommand(cClient, "list")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "No", "channels", "found"))
+
+	cRecorder.Validate()
+}
@@ -0,0 +1,85 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package completion
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cache stores completion candidates on disk so that repeated tab completions
+// don't need to query the cluster each time
+type cache struct {
+	// directory holding the cache files, caching is disabled if empty
+	dir string
+	// time after which cached entries are considered stale
+	ttl time.Duration
+}
+
+// defaultCacheDir returns the directory for the cache files below the
+// user's cache directory
+func defaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "kn", "completion")
+}
+
+// get returns the cached candidates for the given key and whether they are
+// still fresh. found is false if nothing has been cached for this key.
+func (c *cache) get(key string) (candidates []string, fresh bool, found bool) {
+	if c.dir == "" {
+		return nil, false, false
+	}
+	file := c.file(key)
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, false, false
+	}
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, false, false
+	}
+	if len(content) > 0 {
+		candidates = strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	}
+	return candidates, time.Since(info.ModTime()) < c.ttl, true
+}
+
+// put stores the candidates for the given key. Errors are ignored as the cache
+// is only an optimisation.
+func (c *cache) put(key string, candidates []string) {
+	if c.dir == "" {
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return
+	}
+	content := ""
+	if len(candidates) > 0 {
+		content = strings.Join(candidates, "\n") + "\n"
+	}
+	ioutil.WriteFile(c.file(key), []byte(content), 0600)
+}
+
+func (c *cache) file(key string) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%x", sha256.Sum256([]byte(key))))
+}
@@ -0,0 +1,705 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package completion
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+const (
+	// completeFunction is the shell function, which calls 'kn __complete'
+	completeFunction = "__kn_dynamic_complete"
+
+	// cacheTTL is the time for which completion candidates are taken from the cache
+	cacheTTL = 30 * time.Second
+)
+
+var (
+	// completeTimeout is the maximum time spent on querying the cluster for
+	// completion candidates
+	completeTimeout = 2 * time.Second
+
+	// cacheDir is the directory for caching completion candidates, caching
+	// is disabled if empty
+	cacheDir = defaultCacheDir()
+)
+
+const bashCompletionFunction = `
+__kn_dynamic_complete()
+{
+    local line="${COMP_LINE:0:COMP_POINT}" args out prefix c
+    read -r -a args <<< "${line}"
+    if [[ "${line}" == *" " ]]; then
+        args+=("")
+    fi
+    out=$("${args[0]}" __complete "${args[@]:1}" 2>/dev/null) || return
+    # the word to complete might have been split at ':' or '=' by bash
+    prefix="${args[${#args[@]}-1]}"
+    prefix="${prefix%"${cur}"}"
+    while IFS='' read -r c; do
+        COMPREPLY+=("${c#"${prefix}"}")
+    done < <(compgen -W "${out}" -- "${prefix}${cur}")
+    if [[ ${#COMPREPLY[@]} -eq 1 && ${COMPREPLY[0]} == *[:=] ]] && [[ $(type -t compopt) = "builtin" ]]; then
+        compopt -o nospace
+    fi
+}
+
+__kn_custom_func()
+{
+    __kn_dynamic_complete
+}
+`
+
+const zshCompletionFunction = `
+__kn_dynamic_complete()
+{
+    local -a args candidates
+    args=(${(z)LBUFFER})
+    if [[ "${LBUFFER}" == *" " ]]; then
+        args+=("")
+    fi
+    candidates=(${(f)"$(${args[1]} __complete "${(@)args[2,-1]}" 2>/dev/null)"})
+    compadd -- "${candidates[@]}"
+}
+`
+
+// flagsWithDynamicCompletion are the flags whose values are completed with
+// names from the cluster
+var flagsWithDynamicCompletion = map[string]bool{
+	"namespace":        true,
+	"sink":             true,
+	"sink-reply":       true,
+	"sink-dead-letter": true,
+	"broker":           true,
+	"revision":         true,
+	"tag":              true,
+	"untag":            true,
+	"traffic":          true,
+}
+
+// sourceKinds maps the source command groups to the kind of the sources they manage
+var sourceKinds = map[string]string{
+	"apiserver": "ApiServerSource",
+	"binding":   "SinkBinding",
+	"container": "ContainerSource",
+	"ping":      "PingSource",
+}
+
+// NewCompleteCommand returns the hidden command which is called by the shell
+// completion scripts for completing resource names from the cluster
+func NewCompleteCommand(p *commands.KnParams) *cobra.Command {
+	return &cobra.Command{
+		Use:    "__complete [COMMAND]... WORD",
+		Short:  "Print completion candidates for the last word of the given command line",
+		Hidden: true,
+		// All arguments, including flags, are the command line to complete
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := &completer{
+				params:        p,
+				pluginManager: plugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath()),
+				cache:         &cache{dir: cacheDir, ttl: cacheTTL},
+				timeout:       completeTimeout,
+			}
+			for _, candidate := range c.complete(cmd.Root(), args) {
+				fmt.Fprintln(cmd.OutOrStdout(), candidate)
+			}
+			return nil
+		},
+	}
+}
+
+// AddDynamicCompletion hooks 'kn __complete' into the bash and zsh completion
+// scripts generated for the given root command
+func AddDynamicCompletion(rootCmd *cobra.Command) {
+	rootCmd.BashCompletionFunction = bashCompletionFunction
+	rootCmd.ZshCompletionFunction = zshCompletionFunction
+	addDynamicCompletion(rootCmd)
+}
+
+func addDynamicCompletion(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if flagsWithDynamicCompletion[flag.Name] {
+			cobra.MarkFlagCustom(cmd.Flags(), flag.Name, completeFunction)
+		}
+	})
+	if !cmd.HasSubCommands() && completesArguments(cmd) {
+		cmd.MarkZshCompPositionalArgumentCustom(1, completeFunction)
+	}
+	for _, child := range cmd.Commands() {
+		addDynamicCompletion(child)
+	}
+}
+
+// completesArguments returns true if the arguments of the given command are
+// names of existing resources
+func completesArguments(cmd *cobra.Command) bool {
+	switch cmd.Name() {
+	case "create", "import":
+		return false
+	}
+	return cmd.HasParent() && resourceGroup(cmd.Parent()) != ""
+}
+
+// resourceGroup returns the type of resources the given command group manages,
+// or an empty string if it doesn't manage resources with completable names
+func resourceGroup(group *cobra.Command) string {
+	switch group.Name() {
+	case "service", "revision", "broker", "trigger", "source":
+		return group.Name()
+	}
+	if _, ok := sourceKinds[group.Name()]; ok && group.HasParent() && group.Parent().Name() == "source" {
+		return group.Name()
+	}
+	return ""
+}
+
+// completer computes completion candidates for a command line
+type completer struct {
+	params        *commands.KnParams
+	pluginManager *plugin.Manager
+	cache         *cache
+	timeout       time.Duration
+}
+
+// complete returns the candidates for the last of the given words
+func (c *completer) complete(root *cobra.Command, args []string) []string {
+	if len(args) == 0 {
+		return nil
+	}
+	words, toComplete := args[:len(args)-1], args[len(args)-1]
+	if plugin := c.findPlugin(root, words); plugin != nil {
+		return filter(c.completePlugin(plugin, words[len(plugin.CommandParts()):], toComplete), toComplete)
+	}
+	cmd, rest, err := root.Find(words)
+	if err != nil {
+		return nil
+	}
+	// Errors are expected as the command line is incomplete, but all
+	// positional arguments before the word to complete are parsed anyway
+	cmd.ParseFlags(rest)
+	positional := cmd.Flags().Args()
+
+	if len(rest) > 0 {
+		if flag := valueFlag(cmd, rest[len(rest)-1]); flag != nil {
+			return filter(c.completeFlag(cmd, flag.Name, positional, toComplete), toComplete)
+		}
+	}
+	if strings.HasPrefix(toComplete, "--") && strings.Contains(toComplete, "=") {
+		parts := strings.SplitN(toComplete, "=", 2)
+		if flag := valueFlag(cmd, parts[0]); flag != nil {
+			return prefix(filter(c.completeFlag(cmd, flag.Name, positional, parts[1]), parts[1]), parts[0]+"=")
+		}
+		return nil
+	}
+	if strings.HasPrefix(toComplete, "-") {
+		return filter(flagNames(cmd), toComplete)
+	}
+	if cmd.HasSubCommands() {
+		if len(positional) > 0 {
+			return nil
+		}
+		return filter(c.subCommands(cmd), toComplete)
+	}
+	if !completesArguments(cmd) {
+		return nil
+	}
+	// Only 'delete' takes more than one name
+	if len(positional) > 0 && cmd.Name() != "delete" {
+		return nil
+	}
+	return filter(c.completeArgument(cmd, toComplete), toComplete)
+}
+
+// subCommands returns the names of the sub-commands of the given command
+// group, including the ones provided by plugins
+func (c *completer) subCommands(cmd *cobra.Command) []string {
+	var names []string
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.IsAvailableCommand() {
+			names = append(names, subCmd.Name())
+		}
+	}
+	plugins, err := c.pluginManager.ListPlugins()
+	if err != nil {
+		return names
+	}
+	path := commandPath(cmd)
+	for _, plugin := range plugins {
+		parts := plugin.CommandParts()
+		if len(parts) <= len(path) || strings.Join(parts[:len(path)], " ") != strings.Join(path, " ") {
+			continue
+		}
+		if name := parts[len(path)]; !util.SliceContainsIgnoreCase(names, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// findPlugin returns the plugin which handles the command given by the
+// leading words of the command line, or nil if this is a builtin command
+func (c *completer) findPlugin(root *cobra.Command, words []string) plugin.Plugin {
+	var commandWords []string
+	for _, word := range words {
+		if strings.HasPrefix(word, "-") {
+			break
+		}
+		commandWords = append(commandWords, word)
+	}
+	plugin, err := c.pluginManager.FindPlugin(commandWords)
+	if err != nil || plugin == nil {
+		return nil
+	}
+	// Builtin commands can't be overridden by plugins
+	if cmd, rest, err := root.Find(plugin.CommandParts()); err == nil && cmd != root && len(rest) == 0 {
+		return nil
+	}
+	return plugin
+}
+
+// completePlugin asks the plugin for its completion candidates by calling it
+// with '__complete' followed by the arguments and the word to complete. Plugins
+// not supporting this protocol are expected to exit with an error, in which case
+// the sub-commands and flags declared in the plugin's metadata are completed.
+func (c *completer) completePlugin(plugin plugin.Plugin, args []string, toComplete string) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	completeArgs := append(append([]string{"__complete"}, args...), toComplete)
+	out, err := exec.CommandContext(ctx, plugin.Path(), completeArgs...).Output()
+	if err != nil {
+		return completeFromMetadata(plugin, args, toComplete)
+	}
+	var candidates []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			candidates = append(candidates, line)
+		}
+	}
+	return candidates
+}
+
+// completeFromMetadata completes the sub-commands and flags declared in
+// the metadata of a plugin
+func completeFromMetadata(plugin plugin.Plugin, args []string, toComplete string) []string {
+	metadata, err := plugin.Metadata()
+	if err != nil || metadata == nil {
+		return nil
+	}
+	var path []string
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			path = append(path, arg)
+		}
+	}
+	cmd := metadata.Subcommand(path)
+	if cmd == nil {
+		return nil
+	}
+	var candidates []string
+	if strings.HasPrefix(toComplete, "-") {
+		for _, flag := range cmd.Flags {
+			candidates = append(candidates, "--"+flag.Name)
+		}
+		return candidates
+	}
+	for _, sub := range cmd.Subcommands {
+		candidates = append(candidates, sub.Name)
+	}
+	return candidates
+}
+
+func (c *completer) completeArgument(cmd *cobra.Command, toComplete string) []string {
+	namespace := c.namespace(cmd)
+	group := resourceGroup(cmd.Parent())
+	switch group {
+	case "service":
+		return c.services(namespace)
+	case "revision":
+		return c.revisions(namespace, "")
+	case "broker":
+		return c.brokers(namespace)
+	case "trigger":
+		return c.triggers(namespace)
+	case "source":
+		// Generic source commands take TYPE/NAME
+		parts := strings.SplitN(toComplete, "/", 2)
+		if len(parts) == 1 {
+			return suffix(c.sourceTypes(namespace), "/")
+		}
+		return prefix(c.sources(namespace, parts[0]), parts[0]+"/")
+	default:
+		return c.sources(namespace, sourceKinds[group])
+	}
+}
+
+func (c *completer) completeFlag(cmd *cobra.Command, flag string, positional []string, toComplete string) []string {
+	namespace := c.namespace(cmd)
+	service := ""
+	if cmd.HasParent() && cmd.Parent().Name() == "service" && len(positional) > 0 {
+		service = positional[0]
+	}
+	switch flag {
+	case "namespace":
+		return c.namespaces()
+	case "sink", "sink-reply", "sink-dead-letter":
+		return c.sinks(namespace, toComplete)
+	case "broker":
+		return c.brokers(namespace)
+	case "revision", "to":
+		return c.revisions(namespace, service)
+	case "untag":
+		return c.tags(namespace, service)
+	case "tag":
+		return suffix(append([]string{"@latest"}, c.revisions(namespace, service)...), "=")
+	case "traffic":
+		targets := append([]string{"@latest"}, c.tags(namespace, service)...)
+		return suffix(append(targets, c.revisions(namespace, service)...), "=")
+	}
+	return nil
+}
+
+func (c *completer) namespaces() []string {
+	return c.cached("namespaces", func() ([]string, error) {
+		client, err := c.params.NewKubeClient()
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.CoreV1().Namespaces().List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, namespace := range list.Items {
+			names = append(names, namespace.Name)
+		}
+		return names, nil
+	})
+}
+
+func (c *completer) services(namespace string) []string {
+	return c.cached("services|"+namespace, func() ([]string, error) {
+		client, err := c.params.NewServingClient(namespace)
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.ListServices()
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, service := range list.Items {
+			names = append(names, service.Name)
+		}
+		return names, nil
+	})
+}
+
+// revisions returns the names of the revisions of the given service, or of all
+// revisions if no service is given
+func (c *completer) revisions(namespace string, service string) []string {
+	return c.cached("revisions|"+namespace+"|"+service, func() ([]string, error) {
+		client, err := c.params.NewServingClient(namespace)
+		if err != nil {
+			return nil, err
+		}
+		var config []clientservingv1.ListConfig
+		if service != "" {
+			config = append(config, clientservingv1.WithService(service))
+		}
+		list, err := client.ListRevisions(config...)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, revision := range list.Items {
+			names = append(names, revision.Name)
+		}
+		return names, nil
+	})
+}
+
+// tags returns the traffic tags of the given service
+func (c *completer) tags(namespace string, service string) []string {
+	if service == "" {
+		return nil
+	}
+	return c.cached("tags|"+namespace+"|"+service, func() ([]string, error) {
+		client, err := c.params.NewServingClient(namespace)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := client.GetService(service)
+		if err != nil {
+			return nil, err
+		}
+		var tags []string
+		for _, target := range svc.Spec.Traffic {
+			if target.Tag != "" {
+				tags = append(tags, target.Tag)
+			}
+		}
+		return tags, nil
+	})
+}
+
+func (c *completer) brokers(namespace string) []string {
+	return c.cached("brokers|"+namespace, func() ([]string, error) {
+		client, err := c.params.NewEventingClient(namespace)
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.ListBrokers()
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, broker := range list.Items {
+			names = append(names, broker.Name)
+		}
+		return names, nil
+	})
+}
+
+func (c *completer) triggers(namespace string) []string {
+	return c.cached("triggers|"+namespace, func() ([]string, error) {
+		client, err := c.params.NewEventingClient(namespace)
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.ListTriggers()
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, trigger := range list.Items {
+			names = append(names, trigger.Name)
+		}
+		return names, nil
+	})
+}
+
+// sourceTypes returns the kinds of the source types available in the cluster
+func (c *completer) sourceTypes(namespace string) []string {
+	return c.cached("sourcetypes", func() ([]string, error) {
+		client, err := c.params.NewDynamicClient(namespace)
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.ListSourcesTypes()
+		if err != nil {
+			return nil, err
+		}
+		var kinds []string
+		for _, sourceType := range list.Items {
+			kind, _, _ := unstructured.NestedString(sourceType.Object, "spec", "names", "kind")
+			if kind != "" {
+				kinds = append(kinds, kind)
+			}
+		}
+		return kinds, nil
+	})
+}
+
+// sources returns the names of the sources of the given kind
+func (c *completer) sources(namespace string, kind string) []string {
+	return c.cached("sources|"+namespace+"|"+strings.ToLower(kind), func() ([]string, error) {
+		client, err := c.params.NewDynamicClient(namespace)
+		if err != nil {
+			return nil, err
+		}
+		list, err := client.ListSources(clientdynamic.WithTypeFilter(kind))
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, source := range list.Items {
+			names = append(names, source.GetName())
+		}
+		return names, nil
+	})
+}
+
+// sinks completes the sink prefixes, and the names of the resources once a
+// prefix has been given. Names without prefix refer to services.
+func (c *completer) sinks(namespace string, toComplete string) []string {
+	prefixes := flags.SinkPrefixes()
+	parts := strings.SplitN(toComplete, ":", 2)
+	if len(parts) == 2 {
+		gvr, ok := prefixes[parts[0]]
+		if !ok {
+			return nil
+		}
+		names := c.cached("sinks|"+namespace+"|"+gvr.String(), func() ([]string, error) {
+			client, err := c.params.NewDynamicClient(namespace)
+			if err != nil {
+				return nil, err
+			}
+			list, err := client.RawClient().Resource(gvr).Namespace(namespace).List(metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, item := range list.Items {
+				names = append(names, item.GetName())
+			}
+			return names, nil
+		})
+		return prefix(names, parts[0]+":")
+	}
+	var candidates []string
+	for p := range prefixes {
+		candidates = append(candidates, p+":")
+	}
+	sort.Strings(candidates)
+	return append(candidates, c.services(namespace)...)
+}
+
+// cached returns the candidates from the cache if they are fresh enough.
+// Otherwise they are fetched from the cluster, falling back to stale cache
+// entries if this fails or takes too long.
+func (c *completer) cached(key string, list func() ([]string, error)) []string {
+	key = c.contextKey() + "|" + key
+	candidates, fresh, _ := c.cache.get(key)
+	if fresh {
+		return candidates
+	}
+	type result struct {
+		names []string
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		names, err := list()
+		done <- result{names, err}
+	}()
+	select {
+	case r := <-done:
+		if r.err != nil {
+			return candidates
+		}
+		sort.Strings(r.names)
+		c.cache.put(key, r.names)
+		return r.names
+	case <-time.After(c.timeout):
+		return candidates
+	}
+}
+
+// contextKey identifies the cluster the candidates are fetched from, taking
+// the context of the selected profile into account
+func (c *completer) contextKey() string {
+	context, _ := c.params.CurrentContext()
+	return c.params.KubeCfgPath + "|" + context
+}
+
+func (c *completer) namespace(cmd *cobra.Command) string {
+	if cmd.Flag("namespace") != nil {
+		if namespace, err := c.params.GetNamespace(cmd); err == nil && namespace != "" {
+			return namespace
+		}
+	}
+	namespace, err := c.params.CurrentNamespace()
+	if err != nil || namespace == "" {
+		return "default"
+	}
+	return namespace
+}
+
+// commandPath returns the names of the commands leading to the given command,
+// without the root command
+func commandPath(cmd *cobra.Command) []string {
+	var path []string
+	for ; cmd.HasParent(); cmd = cmd.Parent() {
+		path = append([]string{cmd.Name()}, path...)
+	}
+	return path
+}
+
+// flagNames returns the long and short names of the flags of the given command
+func flagNames(cmd *cobra.Command) []string {
+	var names []string
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden {
+			return
+		}
+		names = append(names, "--"+flag.Name)
+		if flag.Shorthand != "" {
+			names = append(names, "-"+flag.Shorthand)
+		}
+	})
+	return names
+}
+
+// valueFlag returns the flag of the command which expects a value, if the
+// given word is such a flag without its value
+func valueFlag(cmd *cobra.Command, word string) *pflag.Flag {
+	var flag *pflag.Flag
+	switch {
+	case strings.HasPrefix(word, "--") && !strings.Contains(word, "="):
+		flag = cmd.Flags().Lookup(word[2:])
+	case strings.HasPrefix(word, "-") && len(word) == 2:
+		flag = cmd.Flags().ShorthandLookup(word[1:])
+	}
+	if flag == nil || flag.NoOptDefVal != "" {
+		return nil
+	}
+	return flag
+}
+
+func filter(candidates []string, toComplete string) []string {
+	var ret []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, toComplete) {
+			ret = append(ret, candidate)
+		}
+	}
+	return ret
+}
+
+func prefix(candidates []string, p string) []string {
+	ret := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		ret[i] = p + candidate
+	}
+	return ret
+}
+
+func suffix(candidates []string, s string) []string {
+	ret := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		ret[i] = candidate + s
+	}
+	return ret
+}
@@ -0,0 +1,356 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package completion
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/service"
+	"knative.dev/client/pkg/kn/commands/source"
+	"knative.dev/client/pkg/kn/config"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestCompleteServiceNames(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.ListServices(mock.Any(), &servingv1.ServiceList{Items: []servingv1.Service{*newService("foo"), *newService("bar"), *newService("foobar")}}, nil)
+	defer setupCacheDir(t)()
+
+	out, err := executeComplete(client, nil, "service", "describe", "fo")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines(out), []string{"foo", "foobar"})
+
+	// Second call is served from the cache
+	out, err = executeComplete(client, nil, "service", "update", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines(out), []string{"bar", "foo", "foobar"})
+
+	r.Validate()
+}
+
+func TestCompleteNoCandidates(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	defer setupCacheDir(t)()
+
+	for _, args := range [][]string{
+		{},
+		{"service", "create", ""},
+		{"service", "describe", "foo", ""},
+		{"service", "describe", "--nonexisting=foo"},
+		{"service", "unknown", ""},
+	} {
+		out, err := executeComplete(client, nil, args...)
+		assert.NilError(t, err)
+		assert.Equal(t, out, "", "args: %v", args)
+	}
+
+	client.Recorder().Validate()
+}
+
+func TestCompleteCommandsAndFlags(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	defer setupCacheDir(t)()
+
+	out, err := executeComplete(client, nil, "service", "")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "create\ndelete\ndescribe\n"))
+	assert.Assert(t, !strings.Contains(out, "help"))
+
+	out, err = executeComplete(client, nil, "service", "describe", "--ou")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "--output\n")
+
+	out, err = executeComplete(client, nil, "service", "describe", "-")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "--namespace\n-n\n"))
+
+	client.Recorder().Validate()
+}
+
+func TestCompletePlugins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts are shell scripts")
+	}
+	client := clientservingv1.NewMockKnServiceClient(t)
+	defer setupCacheDir(t)()
+	pluginsDir, err := ioutil.TempDir("", "kn-plugins")
+	assert.NilError(t, err)
+	defer os.RemoveAll(pluginsDir)
+	for name, script := range map[string]string{
+		"kn-hello":         "#!/bin/sh\nif [ \"$1\" = \"__complete\" ]; then shift; echo \"world\"; echo \"$*\"; fi\n",
+		"kn-source-github": "#!/bin/sh\nexit 1\n",
+		"kn-meta": "#!/bin/sh\nif [ \"$1\" = \"--kn-plugin-metadata\" ]; then echo '" +
+			`{"description": "Meta", "subcommands": [{"name": "create", "flags": [{"name": "topic"}]}, {"name": "delete"}]}` +
+			"'; else exit 1; fi\n",
+	} {
+		assert.NilError(t, ioutil.WriteFile(filepath.Join(pluginsDir, name), []byte(script), 0700))
+	}
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestPluginsDir: pluginsDir}
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	out, err := executeComplete(client, nil, "h")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "hello\n")
+
+	out, err = executeComplete(client, nil, "source", "")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "github\n"))
+
+	out, err = executeComplete(client, nil, "hello", "--name", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines(out), []string{"world", "--name"})
+
+	out, err = executeComplete(client, nil, "source", "github", "")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "")
+
+	out, err = executeComplete(client, nil, "meta", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines(out), []string{"create", "delete"})
+
+	out, err = executeComplete(client, nil, "meta", "create", "--t")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "--topic\n")
+
+	client.Recorder().Validate()
+}
+
+func TestCompleteTrafficFlags(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	svc := newService("foo")
+	svc.Spec.Traffic = []servingv1.TrafficTarget{{Tag: "stable"}, {Tag: "candidate"}, {}}
+	r.GetService("foo", svc, nil)
+	r.ListRevisions(mock.Any(), &servingv1.RevisionList{Items: []servingv1.Revision{*newRevision("foo-v1")}}, nil)
+	defer setupCacheDir(t)()
+
+	out, err := executeComplete(client, nil, "service", "update", "foo", "--untag", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines(out), []string{"candidate", "stable"})
+
+	out, err = executeComplete(client, nil, "service", "update", "foo", "--traffic", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines(out), []string{"@latest=", "candidate=", "stable=", "foo-v1="})
+
+	out, err = executeComplete(client, nil, "service", "rollback", "foo", "--to", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, lines(out), []string{"foo-v1"})
+
+	r.Validate()
+}
+
+func TestCompleteSink(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.ListServices(mock.Any(), &servingv1.ServiceList{Items: []servingv1.Service{*newService("foo")}}, nil)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("current", newServiceUnstructured("foo"), newServiceUnstructured("bar"))
+	defer setupCacheDir(t)()
+
+	out, err := executeComplete(client, dynamicClient, "source", "ping", "create", "p1", "--sink", "")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "broker:\n"))
+	assert.Assert(t, strings.Contains(out, "svc:\n"))
+	assert.Assert(t, strings.HasSuffix(out, "\nfoo\n"))
+
+	out, err = executeComplete(client, dynamicClient, "source", "ping", "create", "p1", "-s", "svc:b")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "svc:bar\n")
+
+	r.Validate()
+}
+
+func TestCompleteStaleCacheOnError(t *testing.T) {
+	defer setupCacheDir(t)()
+	c := &completer{params: &commands.KnParams{}, cache: &cache{dir: cacheDir, ttl: 0}, timeout: 10 * time.Millisecond}
+
+	assert.DeepEqual(t, c.cached("key", func() ([]string, error) { return []string{"b", "a"}, nil }), []string{"a", "b"})
+	assert.DeepEqual(t, c.cached("key", func() ([]string, error) { return nil, errors.New("boom") }), []string{"a", "b"})
+	blocked := make(chan struct{})
+	defer close(blocked)
+	assert.DeepEqual(t, c.cached("key", func() ([]string, error) {
+		<-blocked
+		return nil, nil
+	}), []string{"a", "b"})
+	assert.Assert(t, c.cached("other", func() ([]string, error) { return nil, errors.New("boom") }) == nil)
+}
+
+func TestCompleteCacheIsPerProfileContext(t *testing.T) {
+	defer setupCacheDir(t)()
+	kubeConfig := filepath.Join(cacheDir, "kubeconfig")
+	assert.NilError(t, ioutil.WriteFile(kubeConfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: c
+  cluster:
+    server: https://example.com
+contexts:
+- name: a
+  context:
+    cluster: c
+- name: b
+  context:
+    cluster: c
+current-context: a
+`), 0600))
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	completerFor := func(context string) *completer {
+		config.GlobalConfig = &config.TestConfig{TestProfile: &config.Profile{Context: context}}
+		return &completer{params: &commands.KnParams{KubeCfgPath: kubeConfig}, cache: &cache{dir: cacheDir, ttl: time.Minute}, timeout: 10 * time.Millisecond}
+	}
+	failing := func() ([]string, error) { return nil, errors.New("boom") }
+
+	assert.DeepEqual(t, completerFor("a").cached("services", func() ([]string, error) { return []string{"from-a"}, nil }), []string{"from-a"})
+	// Neither fresh nor stale entries of another profile's context are used
+	assert.DeepEqual(t, completerFor("b").cached("services", func() ([]string, error) { return []string{"from-b"}, nil }), []string{"from-b"})
+	assert.DeepEqual(t, completerFor("a").cached("services", failing), []string{"from-a"})
+	assert.DeepEqual(t, completerFor("b").cached("services", failing), []string{"from-b"})
+	assert.Assert(t, completerFor("staging").cached("services", failing) == nil)
+}
+
+func TestCache(t *testing.T) {
+	defer setupCacheDir(t)()
+	c := &cache{dir: cacheDir, ttl: time.Minute}
+
+	_, _, found := c.get("foo")
+	assert.Assert(t, !found)
+
+	c.put("foo", []string{"a", "b"})
+	candidates, fresh, found := c.get("foo")
+	assert.Assert(t, found && fresh)
+	assert.DeepEqual(t, candidates, []string{"a", "b"})
+
+	c.put("empty", nil)
+	candidates, _, found = c.get("empty")
+	assert.Assert(t, found)
+	assert.Assert(t, candidates == nil)
+
+	c.ttl = 0
+	_, fresh, _ = c.get("foo")
+	assert.Assert(t, !fresh)
+
+	disabled := &cache{ttl: time.Minute}
+	disabled.put("foo", []string{"a"})
+	_, _, found = disabled.get("foo")
+	assert.Assert(t, !found)
+}
+
+func TestAddDynamicCompletion(t *testing.T) {
+	rootCmd := newCompleteTestCommand(&commands.KnParams{})
+	AddDynamicCompletion(rootCmd)
+
+	describe, _, err := rootCmd.Find([]string{"service", "describe"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, describe.Flag("namespace").Annotations[cobra.BashCompCustom], []string{completeFunction})
+
+	create, _, err := rootCmd.Find([]string{"service", "create"})
+	assert.NilError(t, err)
+	assert.Assert(t, completesArguments(describe))
+	assert.Assert(t, !completesArguments(create))
+
+	buf := new(bytes.Buffer)
+	assert.NilError(t, genBashCompletion(rootCmd, buf))
+	assert.Assert(t, strings.Contains(buf.String(), "__kn_custom_func()"))
+	assert.Assert(t, strings.Contains(buf.String(), `__kn_command_groups=("" "service" "source"`))
+	assert.Assert(t, strings.Contains(buf.String(), `"service describe"`))
+
+	buf.Reset()
+	assert.NilError(t, genZshCompletion(rootCmd, buf))
+	assert.Assert(t, strings.Contains(buf.String(), "function _kn_builtin {"))
+	assert.Assert(t, strings.Contains(buf.String(), "function _kn {"))
+}
+
+func executeComplete(client clientservingv1.KnServingClient, dynamicClient clientdynamic.KnDynamicClient, args ...string) (string, error) {
+	p := &commands.KnParams{}
+	rootCmd := newCompleteTestCommand(p)
+	p.NewServingClient = func(namespace string) (clientservingv1.KnServingClient, error) {
+		return client, nil
+	}
+	p.NewDynamicClient = func(namespace string) (clientdynamic.KnDynamicClient, error) {
+		return dynamicClient, nil
+	}
+	output := new(bytes.Buffer)
+	rootCmd.SetOut(output)
+	rootCmd.SetArgs(append([]string{"__complete"}, args...))
+	err := rootCmd.Execute()
+	return output.String(), err
+}
+
+func newCompleteTestCommand(p *commands.KnParams) *cobra.Command {
+	rootCmd, _, _ := commands.CreateTestKnCommand(service.NewServiceCommand(p), p)
+	rootCmd.AddCommand(source.NewSourceCommand(p))
+	rootCmd.AddCommand(NewCompletionCommand(p))
+	rootCmd.AddCommand(NewCompleteCommand(p))
+	return rootCmd
+}
+
+func setupCacheDir(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "kn-completion")
+	assert.NilError(t, err)
+	origCacheDir := cacheDir
+	cacheDir = dir
+	return func() {
+		cacheDir = origCacheDir
+		os.RemoveAll(dir)
+	}
+}
+
+func lines(out string) []string {
+	return strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+}
+
+func newService(name string) *servingv1.Service {
+	return &servingv1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "serving.knative.dev/v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "current"},
+	}
+}
+
+func newServiceUnstructured(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "serving.knative.dev/v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"namespace": "current",
+				"name":      name,
+			},
+		},
+	}
+}
+
+func newRevision(name string) *servingv1.Revision {
+	return &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "current"}}
+}
@@ -15,7 +15,11 @@
 package completion
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -27,41 +31,185 @@ import (
 const (
 	desc = `
 This command prints shell completion code which needs to be evaluated
-to provide interactive completion
+to provide interactive completion. Besides commands and flags, the names
+of services, revisions, brokers, triggers, sources and namespaces are
+completed by querying the cluster. Commands provided by plugins are
+completed, too, and plugins can complete their own arguments when they
+support being called with '__complete' followed by the command line.
 
 Supported Shells:
  - bash
- - zsh`
+ - zsh
+ - fish
+ - powershell`
 	eg = `
  # Generate completion code for bash
  source <(kn completion bash)
 
  # Generate completion code for zsh
  source <(kn completion zsh)
- compdef _kn kn`
+ compdef _kn kn
+
+ # Generate completion code for fish
+ kn completion fish | source
+
+ # Generate completion code for PowerShell
+ kn completion powershell | Out-String | Invoke-Expression`
 )
 
-// NewCompletionCommand implements shell auto-completion feature for Bash and Zsh
+// Completion for fish and PowerShell is delegated completely to 'kn __complete'
+const fishCompletion = `# fish completion for kn
+function __kn_complete
+    set -l args (commandline -opc)
+    $args[1] __complete $args[2..-1] (commandline -ct) 2>/dev/null
+end
+
+complete -c kn -f -a '(__kn_complete)'
+`
+
+const powerShellCompletion = `# PowerShell completion for kn
+Register-ArgumentCompleter -Native -CommandName 'kn' -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+    $elements = @($commandAst.CommandElements | Where-Object { $_.Extent.EndOffset -lt $cursorPosition })
+    if ($wordToComplete -eq '') {
+        $elements = @($commandAst.CommandElements | Where-Object { $_.Extent.EndOffset -le $cursorPosition })
+    }
+    $words = @($elements | Select-Object -Skip 1 | ForEach-Object { "'" + ($_.ToString() -replace "'", "''") + "'" })
+    $word = "'" + ($wordToComplete -replace "'", "''") + "'"
+    if ($wordToComplete -eq '' -and ($PSVersionTable.PSVersion -lt [version]'7.2.0' -or $PSNativeCommandArgumentPassing -eq 'Legacy')) {
+        # Older versions drop empty arguments when calling native commands
+        $word = '` + "`\"`\"" + `'
+    }
+    Invoke-Expression "& '$($elements[0].ToString())' __complete $words $word" 2>$null | ForEach-Object {
+        [System.Management.Automation.CompletionResult]::new($_, $_, 'ParameterValue', $_)
+    }
+}
+`
+
+// Commands of plugins are unknown to the generated bash and zsh completion, so
+// 'kn __complete' takes over as soon as the command line leaves the builtin
+// command groups
+const bashPluginCompletion = `
+__kn_start_with_plugins()
+{
+    local line="${COMP_LINE:0:COMP_POINT}" args path="" w i last
+    read -r -a args <<< "${line}"
+    last=$((${#args[@]} - 1))
+    if [[ "${line}" == *" " ]]; then
+        last=${#args[@]}
+    fi
+    for ((i = 1; i < last; i++)); do
+        w="${args[i]}"
+        if [[ "${w}" == -* ]] || ! __kn_contains_word "${path}" "${__kn_command_groups[@]}"; then
+            break
+        fi
+        if ! __kn_contains_word "${path:+${path} }${w}" "${__kn_command_paths[@]}"; then
+            local cur="${COMP_WORDS[COMP_CWORD]}"
+            COMPREPLY=()
+            __kn_dynamic_complete
+            return
+        fi
+        path="${path:+${path} }${w}"
+    done
+    __start_kn "$@"
+}
+
+complete -o default -F __kn_start_with_plugins kn
+`
+
+const zshPluginCompletion = `
+function _kn {
+  local -a args
+  local line_path="" w i last
+  args=(${(z)LBUFFER})
+  last=${#args}
+  if [[ "${LBUFFER}" == *" " ]]; then
+    last=$((last + 1))
+  fi
+  for ((i = 2; i < last; i++)); do
+    w="${args[i]}"
+    if [[ "${w}" == -* || ${__kn_command_groups[(Ie)${line_path}]} -eq 0 ]]; then
+      break
+    fi
+    if [[ ${__kn_command_paths[(Ie)${line_path:+${line_path} }${w}]} -eq 0 ]]; then
+      __kn_dynamic_complete
+      return
+    fi
+    line_path="${line_path:+${line_path} }${w}"
+  done
+  _kn_builtin
+}
+`
+
+// NewCompletionCommand implements shell auto-completion feature for Bash, Zsh, Fish and PowerShell
 func NewCompletionCommand(p *commands.KnParams) *cobra.Command {
 	return &cobra.Command{
 		Use:       "completion SHELL",
 		Short:     "Output shell completion code",
 		Long:      desc,
-		ValidArgs: []string{"bash", "zsh"},
+		ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
 		Example:   eg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 1 {
 				switch args[0] {
 				case "bash":
-					return cmd.Root().GenBashCompletion(os.Stdout)
+					return genBashCompletion(cmd.Root(), os.Stdout)
 				case "zsh":
-					return cmd.Root().GenZshCompletion(os.Stdout)
+					return genZshCompletion(cmd.Root(), os.Stdout)
+				case "fish":
+					_, err := io.WriteString(os.Stdout, fishCompletion)
+					return err
+				case "powershell":
+					_, err := io.WriteString(os.Stdout, powerShellCompletion)
+					return err
 				default:
-					return errors.New("'bash' or 'zsh' shell completion is supported")
+					return errors.New("'bash', 'zsh', 'fish' or 'powershell' shell completion is supported")
 				}
 			} else {
-				return errors.New("Only one argument can be provided, either 'bash' or 'zsh'")
+				return errors.New("Only one argument can be provided, either 'bash', 'zsh', 'fish' or 'powershell'")
 			}
 		},
 	}
 }
+
+func genBashCompletion(root *cobra.Command, out io.Writer) error {
+	err := root.GenBashCompletion(out)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(out, commandPathsDeclaration(root)+bashPluginCompletion)
+	return err
+}
+
+func genZshCompletion(root *cobra.Command, out io.Writer) error {
+	buf := new(bytes.Buffer)
+	err := root.GenZshCompletion(buf)
+	if err != nil {
+		return err
+	}
+	// Rename the generated entry function so that it can be wrapped
+	script := strings.Replace(buf.String(), "\nfunction _kn {\n", "\nfunction _kn_builtin {\n", 1)
+	_, err = io.WriteString(out, script+commandPathsDeclaration(root)+zshPluginCompletion)
+	return err
+}
+
+// commandPathsDeclaration declares shell arrays holding the paths of all
+// builtin commands and of the command groups, including aliases
+func commandPathsDeclaration(root *cobra.Command) string {
+	var paths, groups []string
+	var collect func(cmd *cobra.Command, path string)
+	collect = func(cmd *cobra.Command, path string) {
+		if cmd.HasSubCommands() {
+			groups = append(groups, fmt.Sprintf("%q", path))
+		}
+		for _, subCmd := range cmd.Commands() {
+			for _, name := range append([]string{subCmd.Name()}, subCmd.Aliases...) {
+				subPath := strings.TrimSpace(path + " " + name)
+				paths = append(paths, fmt.Sprintf("%q", subPath))
+				collect(subCmd, subPath)
+			}
+		}
+	}
+	collect(root, "")
+	return fmt.Sprintf("\n__kn_command_paths=(%s)\n__kn_command_groups=(%s)\n", strings.Join(paths, " "), strings.Join(groups, " "))
+}
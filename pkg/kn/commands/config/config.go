@@ -0,0 +1,53 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigCommand creates the `kn config` command group
+func NewConfigCommand(p *commands.KnParams) *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage kn configuration profiles",
+		Long: `Manage kn configuration profiles
+
+A profile holds defaults for a specific environment like the kubeconfig context,
+the namespace, --wait-timeout, --revision-name, --lock-to-digest and additional sink mappings.
+The profile in use is selected with --profile, $KN_PROFILE or 'kn config use'.`,
+	}
+
+	configCmd.AddCommand(NewConfigUseCommand(p))
+	configCmd.AddCommand(NewConfigGetCommand(p))
+	configCmd.AddCommand(NewConfigSetCommand(p))
+	configCmd.AddCommand(NewConfigViewCommand(p))
+
+	return configCmd
+}
+
+// currentProfile returns the name of the selected profile or an error if no profile is selected
+func currentProfile() (string, error) {
+	name := knconfig.GlobalConfig.ProfileName()
+	if name == "" {
+		return "", errors.New("no profile selected, use 'kn config use NAME' first")
+	}
+	return name, nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+func TestConfigCommandWithoutProfile(t *testing.T) {
+	_, cleanup := setupConfigFile(t, "")
+	defer cleanup()
+
+	for _, args := range [][]string{{"get", "namespace"}, {"set", "namespace", "team-a"}} {
+		_, err := executeConfigCommand(args...)
+		assert.ErrorContains(t, err, "no profile selected")
+		assert.ErrorContains(t, err, "kn config use NAME")
+	}
+}
+
+func TestConfigCommandArguments(t *testing.T) {
+	_, cleanup := setupConfigFile(t, "staging")
+	defer cleanup()
+
+	for _, args := range [][]string{{"use"}, {"get"}, {"set", "namespace"}} {
+		_, err := executeConfigCommand(args...)
+		assert.ErrorContains(t, err, "'config "+args[0]+"' requires")
+	}
+}
+
+// setupConfigFile points the global configuration to a temporary config file
+// and selects the given profile
+func setupConfigFile(t *testing.T, profile string) (string, func()) {
+	tmpDir, err := ioutil.TempDir("", "kn-config")
+	assert.NilError(t, err)
+	configFile := filepath.Join(tmpDir, "config.yaml")
+	if profile != "" {
+		err = knconfig.UseProfile(configFile, profile)
+		assert.NilError(t, err)
+	}
+
+	oldConfig := knconfig.GlobalConfig
+	knconfig.GlobalConfig = &knconfig.TestConfig{
+		TestConfigFile:  configFile,
+		TestProfileName: profile,
+	}
+	return configFile, func() {
+		knconfig.GlobalConfig = oldConfig
+		os.RemoveAll(tmpDir)
+	}
+}
+
+func executeConfigCommand(args ...string) (string, error) {
+	output := new(bytes.Buffer)
+	cmd := NewConfigCommand(&commands.KnParams{})
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
@@ -0,0 +1,56 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigGetCommand creates a new `kn config get` command
+func NewConfigGetCommand(p *commands.KnParams) *cobra.Command {
+	configGetCommand := &cobra.Command{
+		Use:   "get KEY",
+		Short: "Print a value of the current profile",
+		Long: `Print a value of the current profile.
+
+Supported keys: ` + strings.Join(knconfig.ProfileKeys, ", "),
+		Example: `
+  # Print the namespace of the current profile
+  kn config get namespace`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'config get' requires the key given as single argument")
+			}
+			profile, err := currentProfile()
+			if err != nil {
+				return err
+			}
+			value, err := knconfig.GetProfileValue(knconfig.GlobalConfig.ConfigFile(), profile, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), value)
+			return nil
+		},
+	}
+	return configGetCommand
+}
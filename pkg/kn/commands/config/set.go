@@ -0,0 +1,60 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigSetCommand creates a new `kn config set` command
+func NewConfigSetCommand(p *commands.KnParams) *cobra.Command {
+	configSetCommand := &cobra.Command{
+		Use:   "set KEY VALUE",
+		Short: "Set a value of the current profile",
+		Long: `Set a value of the current profile. An empty value removes the key from the profile.
+
+Supported keys: ` + strings.Join(knconfig.ProfileKeys, ", "),
+		Example: `
+  # Deploy to the namespace 'team-a' and wait at most two minutes by default
+  kn config set namespace team-a
+  kn config set wait-timeout 120
+
+  # Don't resolve image tags to digests
+  kn config set lock-to-digest false`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("'config set' requires the key and the value as arguments")
+			}
+			profile, err := currentProfile()
+			if err != nil {
+				return err
+			}
+			err = knconfig.SetProfileValue(knconfig.GlobalConfig.ConfigFile(), profile, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Set '%s' in profile '%s'.\n", args[0], profile)
+			return nil
+		},
+	}
+	return configSetCommand
+}
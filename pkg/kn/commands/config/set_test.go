@@ -0,0 +1,46 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestConfigSetAndGet(t *testing.T) {
+	_, cleanup := setupConfigFile(t, "staging")
+	defer cleanup()
+
+	out, err := executeConfigCommand("set", "wait-timeout", "120")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Set", "wait-timeout", "staging"))
+
+	out, err = executeConfigCommand("get", "wait-timeout")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "120\n")
+
+	out, err = executeConfigCommand("get", "namespace")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "\n")
+
+	_, err = executeConfigCommand("set", "wait-timeout", "soon")
+	assert.ErrorContains(t, err, "invalid value 'soon'")
+
+	_, err = executeConfigCommand("get", "color")
+	assert.ErrorContains(t, err, "unknown profile key 'color'")
+}
@@ -0,0 +1,52 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigUseCommand creates a new `kn config use` command
+func NewConfigUseCommand(p *commands.KnParams) *cobra.Command {
+	configUseCommand := &cobra.Command{
+		Use:   "use NAME",
+		Short: "Select the profile to use",
+		Long: `Select the profile to use by default.
+
+The profile is created if it doesn't exist yet. --profile and $KN_PROFILE take precedence over the selected profile.`,
+		Example: `
+  # Use the profile 'staging' and set its kubeconfig context
+  kn config use staging
+  kn config set context staging-cluster`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'config use' requires the profile name given as single argument")
+			}
+			err := knconfig.UseProfile(knconfig.GlobalConfig.ConfigFile(), args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Using profile '%s'.\n", args[0])
+			return nil
+		},
+	}
+	return configUseCommand
+}
@@ -0,0 +1,42 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"gotest.tools/assert"
+
+	knconfig "knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/util"
+)
+
+func TestConfigUse(t *testing.T) {
+	configFile, cleanup := setupConfigFile(t, "")
+	defer cleanup()
+
+	out, err := executeConfigCommand("use", "staging")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Using profile", "staging"))
+
+	data, err := ioutil.ReadFile(configFile)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(string(data), "current-profile: staging", "profiles:", "staging: {}"))
+
+	names, err := knconfig.ProfileNames(configFile)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names, []string{"staging"})
+}
@@ -0,0 +1,51 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knconfig "knative.dev/client/pkg/kn/config"
+)
+
+// NewConfigViewCommand creates a new `kn config view` command
+func NewConfigViewCommand(p *commands.KnParams) *cobra.Command {
+	configViewCommand := &cobra.Command{
+		Use:   "view",
+		Short: "Print the configuration file",
+		Example: `
+  # Print the configuration including all profiles
+  kn config view`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configFile := knconfig.GlobalConfig.ConfigFile()
+			data, err := ioutil.ReadFile(configFile)
+			if err != nil {
+				if os.IsNotExist(err) {
+					fmt.Fprintf(cmd.OutOrStdout(), "No configuration file %s found.\n", configFile)
+					return nil
+				}
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		},
+	}
+	return configViewCommand
+}
@@ -0,0 +1,38 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestConfigView(t *testing.T) {
+	configFile, cleanup := setupConfigFile(t, "")
+	defer cleanup()
+
+	out, err := executeConfigCommand("view")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "No configuration file", configFile))
+
+	_, err = executeConfigCommand("use", "staging")
+	assert.NilError(t, err)
+	out, err = executeConfigCommand("view")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "current-profile: staging", "profiles:"))
+}
@@ -0,0 +1,88 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	clientserving "knative.dev/client/pkg/serving"
+)
+
+// DiffFlags holds the flags for printing a diff
+type DiffFlags struct {
+	Output string
+}
+
+// Add adds the diff flags to the given command
+func (d *DiffFlags) Add(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&d.Output, "output", "o", "", "Output format. One of: json.")
+}
+
+// Validate checks the given output format
+func (d *DiffFlags) Validate() error {
+	if d.Output != "" && strings.ToLower(d.Output) != "json" {
+		return fmt.Errorf("invalid value '%s' for --output, only 'json' is supported", d.Output)
+	}
+	return nil
+}
+
+// fieldDiffs is the machine readable representation of a diff
+type fieldDiffs struct {
+	From    string                    `json:"from"`
+	To      string                    `json:"to"`
+	Changes []clientserving.FieldDiff `json:"changes"`
+}
+
+// PrintDiff prints the differences between from and to, either as JSON or in
+// a human readable form with added fields marked with '+', removed fields
+// with '-' and changed fields with '~'
+func (d *DiffFlags) PrintDiff(out io.Writer, from string, to string, diffs []clientserving.FieldDiff) error {
+	if d.Output != "" {
+		b, err := json.MarshalIndent(fieldDiffs{From: from, To: to, Changes: diffs}, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+		return nil
+	}
+
+	if len(diffs) == 0 {
+		fmt.Fprintf(out, "No differences between %s and %s.\n", from, to)
+		return nil
+	}
+	fmt.Fprintf(out, "Differences between %s and %s:\n", from, to)
+	padding := 0
+	for _, diff := range diffs {
+		if len(diff.Field) > padding {
+			padding = len(diff.Field)
+		}
+	}
+	for _, diff := range diffs {
+		switch {
+		case diff.From == "":
+			fmt.Fprintf(out, "+ %-*s  %s\n", padding, diff.Field, diff.To)
+		case diff.To == "":
+			fmt.Fprintf(out, "- %-*s  %s\n", padding, diff.Field, diff.From)
+		default:
+			fmt.Fprintf(out, "~ %-*s  %s -> %s\n", padding, diff.Field, diff.From, diff.To)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/assert"
+
+	clientserving "knative.dev/client/pkg/serving"
+)
+
+func TestPrintDiff(t *testing.T) {
+	diffs := []clientserving.FieldDiff{
+		{Field: "image", From: "a", To: "b"},
+		{Field: "env[FOO]", To: "bar"},
+		{Field: "mount[/data]", From: "cm:data"},
+	}
+
+	flags := DiffFlags{}
+	out := new(bytes.Buffer)
+	assert.NilError(t, flags.PrintDiff(out, "revision 'foo-v1'", "revision 'foo-v2'", diffs))
+	assert.Equal(t, out.String(), `Differences between revision 'foo-v1' and revision 'foo-v2':
+~ image         a -> b
++ env[FOO]      bar
+- mount[/data]  cm:data
+`)
+
+	out.Reset()
+	assert.NilError(t, flags.PrintDiff(out, "a", "b", []clientserving.FieldDiff{}))
+	assert.Equal(t, out.String(), "No differences between a and b.\n")
+
+	flags.Output = "json"
+	assert.NilError(t, flags.Validate())
+	out.Reset()
+	assert.NilError(t, flags.PrintDiff(out, "a", "b", diffs))
+	result := fieldDiffs{}
+	assert.NilError(t, json.Unmarshal(out.Bytes(), &result))
+	assert.DeepEqual(t, result, fieldDiffs{From: "a", To: "b", Changes: diffs})
+
+	flags.Output = "yaml"
+	assert.ErrorContains(t, flags.Validate(), "only 'json' is supported")
+}
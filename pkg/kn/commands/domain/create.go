@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	knservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
+)
+
+var createExample = `
+  # Map the domain 'hello.example.com' to the service 'hello'
+  kn domain create hello.example.com --ref hello
+
+  # Map the domain 'events.example.com' to the broker 'default'
+  kn domain create events.example.com --ref broker:default`
+
+// NewDomainCreateCommand is for creating a DomainMapping
+func NewDomainCreateCommand(p *commands.KnParams) *cobra.Command {
+	var refFlags RefFlags
+
+	cmd := &cobra.Command{
+		Use:     "create DOMAIN --ref TARGET",
+		Short:   "Create a domain mapping",
+		Example: createExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'domain create' requires the domain name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+			ref, err := refFlags.Resolve(dynamicClient, namespace)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingV1alpha1Client(namespace)
+			if err != nil {
+				return err
+			}
+
+			domainMapping := knservingv1alpha1.NewDomainMappingBuilder(name).
+				Namespace(namespace).
+				Reference(*ref).
+				Build()
+			err = client.CreateDomainMapping(domainMapping)
+			if err != nil {
+				return fmt.Errorf(
+					"cannot create domain mapping '%s' in namespace '%s' "+
+						"because: %s", name, namespace, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Domain mapping '%s' created in namespace '%s'.\n", name, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	refFlags.Add(cmd)
+	cmd.MarkFlagRequired("ref")
+	return cmd
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestCreateDomainMapping(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", createServiceObject("hello"))
+
+	recorder := client.Recorder()
+	recorder.CreateDomainMapping(createDomainMapping("hello.example.com", createServiceRef("hello")), nil)
+
+	out, err := executeDomainCommand(client, dynamicClient, "create", "hello.example.com", "--ref", "svc:hello")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Domain mapping", "hello.example.com", "created", "default"))
+
+	recorder.Validate()
+}
+
+func TestCreateDomainMappingErrorCase(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", createServiceObject("hello"))
+
+	_, err := executeDomainCommand(client, dynamicClient, "create", "--ref", "hello")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeDomainCommand(client, dynamicClient, "create", "hello.example.com")
+	assert.ErrorContains(t, err, "\"ref\" not set")
+
+	_, err = executeDomainCommand(client, dynamicClient, "create", "hello.example.com", "--ref", "absent")
+	assert.ErrorContains(t, err, "\"absent\" not found")
+
+	_, err = executeDomainCommand(client, dynamicClient, "create", "hello.example.com", "--ref", "https://example.com")
+	assert.ErrorContains(t, err, "can't be mapped to the URI")
+
+	_, err = executeDomainCommand(client, dynamicClient, "create", "hello.example.com", "--ref", "foo:hello")
+	assert.ErrorContains(t, err, "unsupported sink type")
+}
+
+func TestCreateDomainMappingError(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", createServiceObject("hello"))
+
+	recorder := client.Recorder()
+	recorder.CreateDomainMapping(createDomainMapping("hello.example.com", createServiceRef("hello")), fmt.Errorf("boom"))
+
+	_, err := executeDomainCommand(client, dynamicClient, "create", "hello.example.com", "--ref", "hello")
+	assert.ErrorContains(t, err, "cannot create domain mapping 'hello.example.com'")
+	assert.ErrorContains(t, err, "boom")
+
+	recorder.Validate()
+}
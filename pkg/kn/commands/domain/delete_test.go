@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDeleteDomainMapping(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	recorder := client.Recorder()
+	recorder.DeleteDomainMapping("hello.example.com", nil)
+
+	out, err := executeDomainCommand(client, dynamicClient, "delete", "hello.example.com")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Domain mapping", "hello.example.com", "deleted", "default"))
+
+	recorder.Validate()
+}
+
+func TestDeleteDomainMappingError(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	_, err := executeDomainCommand(client, dynamicClient, "delete")
+	assert.ErrorContains(t, err, "single argument")
+
+	recorder := client.Recorder()
+	recorder.DeleteDomainMapping("hello.example.com", fmt.Errorf("domainmapping.serving.knative.dev \"hello.example.com\" not found"))
+
+	_, err = executeDomainCommand(client, dynamicClient, "delete", "hello.example.com")
+	assert.ErrorContains(t, err, "not found")
+
+	recorder.Validate()
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/printers"
+	knservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
+)
+
+// NewDomainDescribeCommand returns a new command for describing a DomainMapping
+func NewDomainDescribeCommand(p *commands.KnParams) *cobra.Command {
+
+	// For machine readable output
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+
+	cmd := &cobra.Command{
+		Use:   "describe DOMAIN",
+		Short: "Show details of a domain mapping",
+		Example: `
+  # Describe the domain mapping 'hello.example.com'
+  kn domain describe hello.example.com
+
+  # Describe the domain mapping 'hello.example.com' in YAML format
+  kn domain describe hello.example.com -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'domain describe' requires the domain name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingV1alpha1Client(namespace)
+			if err != nil {
+				return err
+			}
+
+			domainMapping, err := client.GetDomainMapping(name)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+
+			// Print out machine readable output if requested
+			if machineReadablePrintFlags.OutputFlagSpecified() {
+				printer, err := machineReadablePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				return printer.PrintObj(domainMapping, out)
+			}
+
+			printDetails, err := cmd.Flags().GetBool("verbose")
+			if err != nil {
+				return err
+			}
+
+			dw := printers.NewPrefixWriter(out)
+			writeDomainMapping(dw, domainMapping, printDetails)
+			dw.WriteLine()
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+
+			writeRef(dw, domainMapping)
+			dw.WriteLine()
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+
+			commands.WriteConditions(dw, domainMapping.Status.Conditions, printDetails)
+			return dw.Flush()
+		},
+	}
+	flags := cmd.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.BoolP("verbose", "v", false, "More output.")
+	machineReadablePrintFlags.AddFlags(cmd)
+	return cmd
+}
+
+func writeDomainMapping(dw printers.PrefixWriter, domainMapping *knservingv1alpha1.DomainMapping, printDetails bool) {
+	commands.WriteMetadata(dw, &domainMapping.ObjectMeta, printDetails)
+	if domainMapping.Status.URL != nil {
+		dw.WriteAttribute("URL", domainMapping.Status.URL.String())
+	}
+	if printDetails && domainMapping.Status.Address != nil && domainMapping.Status.Address.URL != nil {
+		dw.WriteAttribute("Cluster", domainMapping.Status.Address.URL.String())
+	}
+}
+
+func writeRef(dw printers.PrefixWriter, domainMapping *knservingv1alpha1.DomainMapping) {
+	ref := domainMapping.Spec.Ref
+	section := dw.WriteAttribute("Reference", "")
+	section.WriteAttribute("Name", ref.Name)
+	if ref.Namespace != "" {
+		section.WriteAttribute("Namespace", ref.Namespace)
+	}
+	section.WriteAttribute("Resource", fmt.Sprintf("%s (%s)", ref.Kind, ref.APIVersion))
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDescribeDomainMapping(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	recorder := client.Recorder()
+	recorder.GetDomainMapping("hello.example.com", createReadyDomainMapping("hello.example.com", createServiceRef("hello")), nil)
+
+	out, err := executeDomainCommand(client, dynamicClient, "describe", "hello.example.com")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Name:", "hello.example.com", "URL:", "https://hello.example.com"))
+	assert.Assert(t, util.ContainsAll(out, "Reference:", "hello", "Service (serving.knative.dev/v1)"))
+	assert.Assert(t, util.ContainsAll(out, "Conditions:", "Ready"))
+
+	recorder.Validate()
+}
+
+func TestDescribeDomainMappingMachineReadable(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	recorder := client.Recorder()
+	recorder.GetDomainMapping("hello.example.com", createDomainMapping("hello.example.com", duckv1.KReference{Kind: "Broker", APIVersion: "eventing.knative.dev/v1beta1", Name: "default"}), nil)
+
+	out, err := executeDomainCommand(client, dynamicClient, "describe", "hello.example.com", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "name: hello.example.com", "kind: Broker"))
+
+	recorder.Validate()
+}
+
+func TestDescribeDomainMappingError(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	_, err := executeDomainCommand(client, dynamicClient, "describe")
+	assert.ErrorContains(t, err, "single argument")
+
+	recorder := client.Recorder()
+	recorder.GetDomainMapping("hello.example.com", nil, fmt.Errorf("boom"))
+
+	_, err = executeDomainCommand(client, dynamicClient, "describe", "hello.example.com")
+	assert.ErrorContains(t, err, "boom")
+
+	recorder.Validate()
+}
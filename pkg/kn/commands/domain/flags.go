@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/kn/commands/flags"
+)
+
+// RefFlags holds the Addressable a domain is mapped to, given with --ref
+type RefFlags struct {
+	flags.SinkFlags
+}
+
+// Add adds the --ref flag to the given command
+func (r *RefFlags) Add(cmd *cobra.Command) {
+	r.AddWithFlagName(cmd, "ref", "", "Addressable target of the domain mapping. "+
+		"Examples: '--ref svc:mysvc' or '--ref mysvc' for a Knative service, '--ref broker:default' for a broker.")
+}
+
+// Resolve returns the reference to the Addressable given with --ref. It
+// validates that the referred object exists.
+func (r *RefFlags) Resolve(client dynamic.KnDynamicClient, namespace string) (*duckv1.KReference, error) {
+	destination, err := r.ResolveSink(client, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if destination == nil {
+		return nil, fmt.Errorf("required flag(s) \"ref\" not set")
+	}
+	if destination.Ref == nil {
+		return nil, fmt.Errorf("a domain can't be mapped to the URI '%s', use a reference to a Kubernetes resource instead", destination.URI)
+	}
+	return destination.Ref, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	hprinters "knative.dev/client/pkg/printers"
+	knservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
+)
+
+var listExample = `
+  # List all domain mappings
+  kn domain list
+
+  # List domain mappings in YAML format
+  kn domain list -o yaml`
+
+// NewDomainListCommand is for listing DomainMappings
+func NewDomainListCommand(p *commands.KnParams) *cobra.Command {
+	domainListFlags := flags.NewListPrintFlags(ListHandlers)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List domain mappings",
+		Aliases: []string{"ls"},
+		Example: listExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingV1alpha1Client(namespace)
+			if err != nil {
+				return err
+			}
+
+			domainMappingList, err := client.ListDomainMappings()
+			if err != nil {
+				return err
+			}
+			if len(domainMappingList.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No domain mappings found.\n")
+				return nil
+			}
+
+			// empty namespace indicates all-namespaces flag is specified
+			if namespace == "" {
+				domainListFlags.EnsureWithNamespace()
+			}
+
+			return domainListFlags.Print(domainMappingList, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), true)
+	domainListFlags.AddFlags(cmd)
+	return cmd
+}
+
+// ListHandlers adds print handlers for domain list command
+func ListHandlers(h hprinters.PrintHandler) {
+	domainColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Namespace", Type: "string", Description: "Namespace of the DomainMapping", Priority: 0},
+		{Name: "Name", Type: "string", Description: "Domain of the DomainMapping", Priority: 1},
+		{Name: "URL", Type: "string", Description: "URL of the DomainMapping", Priority: 1},
+		{Name: "Ref", Type: "string", Description: "Target the domain is mapped to", Priority: 1},
+		{Name: "Age", Type: "string", Description: "Age of the DomainMapping", Priority: 1},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the DomainMapping", Priority: 1},
+		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+	}
+	h.TableHandler(domainColumnDefinitions, printDomainMapping)
+	h.TableHandler(domainColumnDefinitions, printDomainMappingList)
+}
+
+func printDomainMappingList(domainMappingList *knservingv1alpha1.DomainMappingList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(domainMappingList.Items))
+	sort.SliceStable(domainMappingList.Items, func(i, j int) bool {
+		return domainMappingList.Items[i].Name < domainMappingList.Items[j].Name
+	})
+	for i := range domainMappingList.Items {
+		r, err := printDomainMapping(&domainMappingList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printDomainMapping(domainMapping *knservingv1alpha1.DomainMapping, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	url := ""
+	if domainMapping.Status.URL != nil {
+		url = domainMapping.Status.URL.String()
+	}
+	ref := refToString(domainMapping)
+	age := commands.TranslateTimestampSince(domainMapping.CreationTimestamp)
+	ready := commands.ReadyCondition(domainMapping.Status.Conditions)
+	reason := commands.NonReadyConditionReason(domainMapping.Status.Conditions)
+
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: domainMapping},
+	}
+
+	if options.AllNamespaces {
+		row.Cells = append(row.Cells, domainMapping.Namespace)
+	}
+
+	row.Cells = append(row.Cells, domainMapping.Name, url, ref, age, ready, reason)
+	return []metav1beta1.TableRow{row}, nil
+}
+
+// refToString prepares the reference of a domain mapping for list output
+func refToString(domainMapping *knservingv1alpha1.DomainMapping) string {
+	ref := domainMapping.Spec.Ref
+	return flags.SinkToString(duckv1.Destination{Ref: &ref})
+}
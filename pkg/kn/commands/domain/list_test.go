@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestListDomainMappings(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	domainMappingList := &knservingv1alpha1.DomainMappingList{Items: []knservingv1alpha1.DomainMapping{
+		*createReadyDomainMapping("hello.example.com", createServiceRef("hello")),
+		*createDomainMapping("events.example.com", duckv1.KReference{Kind: "Broker", APIVersion: "eventing.knative.dev/v1beta1", Name: "default"}),
+	}}
+	recorder := client.Recorder()
+	recorder.ListDomainMappings(domainMappingList, nil)
+
+	out, err := executeDomainCommand(client, dynamicClient, "list")
+	assert.NilError(t, err)
+	lines := strings.Split(out, "\n")
+	assert.Check(t, util.ContainsAll(lines[0], "NAME", "URL", "REF", "AGE", "READY", "REASON"))
+	assert.Check(t, util.ContainsAll(lines[1], "events.example.com", "broker:default"))
+	assert.Check(t, util.ContainsAll(lines[2], "hello.example.com", "https://hello.example.com", "svc:hello", "True"))
+
+	recorder.Validate()
+}
+
+func TestListDomainMappingsEmpty(t *testing.T) {
+	client := knservingv1alpha1.NewMockKnServingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	recorder := client.Recorder()
+	recorder.ListDomainMappings(&knservingv1alpha1.DomainMappingList{}, nil)
+
+	out, err := executeDomainCommand(client, dynamicClient, "list")
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(out, "No domain mappings found"))
+
+	recorder.Validate()
+}
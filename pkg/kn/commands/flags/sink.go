@@ -33,7 +33,13 @@ type SinkFlags struct {
 }
 
 func (i *SinkFlags) Add(cmd *cobra.Command) {
-	cmd.Flags().StringVarP(&i.sink, "sink", "s", "", "Addressable sink for events")
+	i.AddWithFlagName(cmd, "sink", "s", "Addressable sink for events")
+}
+
+// AddWithFlagName configures the sink flag with the given flag name, an
+// optional shorthand (empty if none) and usage text
+func (i *SinkFlags) AddWithFlagName(cmd *cobra.Command, fname, short, usage string) {
+	cmd.Flags().StringVarP(&i.sink, fname, short, "", usage)
 
 	for _, p := range config.GlobalConfig.SinkMappings() {
 		//user configration might override the default configuration
@@ -52,6 +58,11 @@ var sinkMappings = map[string]schema.GroupVersionResource{
 		Group:    "eventing.knative.dev",
 		Version:  "v1beta1",
 	},
+	"channel": {
+		Resource: "channels",
+		Group:    "messaging.knative.dev",
+		Version:  "v1beta1",
+	},
 	"service": {
 		Resource: "services",
 		Group:    "serving.knative.dev",
@@ -65,6 +76,16 @@ var sinkMappings = map[string]schema.GroupVersionResource{
 	},
 }
 
+// SinkPrefixes returns the prefixes which can be used for sinks together with
+// the resources they refer to
+func SinkPrefixes() map[string]schema.GroupVersionResource {
+	prefixes := make(map[string]schema.GroupVersionResource, len(sinkMappings))
+	for prefix, gvr := range sinkMappings {
+		prefixes[prefix] = gvr
+	}
+	return prefixes
+}
+
 // ResolveSink returns the Destination referred to by the flags in the acceptor.
 // It validates that any object the user is referring to exists.
 func (i *SinkFlags) ResolveSink(knclient clientdynamic.KnDynamicClient, namespace string) (*duckv1.Destination, error) {
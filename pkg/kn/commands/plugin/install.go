@@ -0,0 +1,86 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/kn/plugin"
+)
+
+// NewPluginInstallCommand creates a new `kn plugin install` command
+func NewPluginInstallCommand(p *commands.KnParams) *cobra.Command {
+	var indexLocation string
+	pluginInstallCommand := &cobra.Command{
+		Use:   "install NAME",
+		Short: "Install a plugin from a plugin index",
+		Long: `Install a plugin from a plugin index.
+
+The plugin index is a YAML file listing the available plugins together with
+their version and a download URL and SHA-256 checksum per platform. It is read
+from the location given with --index or configured as plugins.index in the kn
+configuration file. The plugin is installed into kn's plugin directory.`,
+		Example: `
+  # Install the plugin 'source-kafka' from the configured plugin index
+  kn plugin install source-kafka
+
+  # Install the plugin 'admin' from a local plugin index
+  kn plugin install admin --index ./plugins.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'plugin install' requires the plugin name given as single argument")
+			}
+			index, err := loadIndex(indexLocation)
+			if err != nil {
+				return err
+			}
+			installed, err := newPluginManager().Install(index, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Plugin '%s' %s installed in '%s'.\n", installed.Name, installed.Version, config.GlobalConfig.PluginsDir())
+			return nil
+		},
+	}
+	addIndexFlag(pluginInstallCommand, &indexLocation)
+	return pluginInstallCommand
+}
+
+// addIndexFlag adds the flag for overriding the configured plugin index
+func addIndexFlag(cmd *cobra.Command, indexLocation *string) {
+	cmd.Flags().StringVar(indexLocation, "index", "", "Location of the plugin index as file or HTTP(S) URL (default: plugins.index from configuration)")
+}
+
+// loadIndex loads the plugin index from the given location or
+// from the configured one, if no location is given
+func loadIndex(location string) (*plugin.Index, error) {
+	if location == "" {
+		location = config.GlobalConfig.PluginIndex()
+	}
+	if location == "" {
+		return nil, errors.New("no plugin index configured, please use --index or set plugins.index in the kn configuration file")
+	}
+	return plugin.LoadIndex(location)
+}
+
+// newPluginManager returns a plugin manager for the configured plugin directory
+func newPluginManager() *plugin.Manager {
+	return plugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+}
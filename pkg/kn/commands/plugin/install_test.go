@@ -0,0 +1,113 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/config"
+	"knative.dev/client/pkg/util"
+)
+
+func TestPluginInstall(t *testing.T) {
+	pluginDir, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+	indexFile := writeTestIndex(t, pluginDir, "v0.1.0")
+
+	out, err := executePluginCommand("install", "test", "--index", indexFile)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Plugin", "test", "v0.1.0", "installed", pluginDir))
+
+	out, err = executePluginCommand("list")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kn-test"))
+
+	_, err = executePluginCommand("install", "test", "--index", indexFile)
+	assert.ErrorContains(t, err, "already installed")
+}
+
+func TestPluginInstallWithConfiguredIndex(t *testing.T) {
+	pluginDir, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+	indexFile := writeTestIndex(t, pluginDir, "v0.1.0")
+
+	_, err := executePluginCommand("install", "test")
+	assert.ErrorContains(t, err, "no plugin index configured")
+
+	config.GlobalConfig.(*config.TestConfig).TestPluginIndex = indexFile
+	_, err = executePluginCommand("install", "test")
+	assert.NilError(t, err)
+}
+
+func TestPluginInstallErrors(t *testing.T) {
+	pluginDir, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+	indexFile := writeTestIndex(t, pluginDir, "v0.1.0")
+
+	_, err := executePluginCommand("install", "--index", indexFile)
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executePluginCommand("install", "bogus", "--index", indexFile)
+	assert.ErrorContains(t, err, "no plugin 'bogus'")
+
+	_, err = executePluginCommand("install", "test", "--index", filepath.Join(pluginDir, "bogus.yaml"))
+	assert.ErrorContains(t, err, "cannot read plugin index")
+}
+
+// Private
+
+// Write an index for a plugin 'test' with the given version into the given
+// directory and return the index file
+func writeTestIndex(t *testing.T, dir string, version string) string {
+	content := "plugin " + version
+	binary := filepath.Join(dir, "download-"+version)
+	err := ioutil.WriteFile(binary, []byte(content), 0644)
+	assert.NilError(t, err)
+	sum := sha256.Sum256([]byte(content))
+
+	index := fmt.Sprintf(`
+plugins:
+- name: test
+  version: %s
+  platforms:
+  - os: %s
+    arch: %s
+    url: %s
+    sha256: %s
+`, version, runtime.GOOS, runtime.GOARCH, filepath.Base(binary), hex.EncodeToString(sum[:]))
+	indexFile := filepath.Join(dir, "index.yaml")
+	err = ioutil.WriteFile(indexFile, []byte(index), 0644)
+	assert.NilError(t, err)
+	return indexFile
+}
+
+func executePluginCommand(args ...string) (string, error) {
+	output := new(bytes.Buffer)
+	cmd := NewPluginCommand(&commands.KnParams{})
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
@@ -16,6 +16,7 @@ package plugin
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -23,7 +24,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"knative.dev/client/pkg/kn/commands"
-	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/kn/plugin"
 )
 
@@ -62,7 +62,7 @@ Available plugins are those that are:
 
 // List plugins by looking up in plugin directory and path
 func listPlugins(cmd *cobra.Command, flags pluginListFlags) error {
-	factory := plugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+	factory := newPluginManager()
 
 	pluginsFound, err := factory.ListPlugins()
 	if err != nil {
@@ -97,6 +97,7 @@ func listPlugins(cmd *cobra.Command, flags pluginListFlags) error {
 		}
 		if flags.verbose {
 			fmt.Fprintf(out, "  (%s)\n", pl.Path())
+			printMetadata(out, pl)
 		} else {
 			fmt.Fprintln(out, "")
 		}
@@ -111,6 +112,34 @@ func listPlugins(cmd *cobra.Command, flags pluginListFlags) error {
 	return nil
 }
 
+// print the version and sub-commands of a plugin, if provided by the plugin
+func printMetadata(out io.Writer, pl plugin.Plugin) {
+	metadata, _ := pl.Metadata()
+	if metadata == nil {
+		return
+	}
+	if metadata.Version != "" {
+		fmt.Fprintf(out, "    version: %s\n", metadata.Version)
+	}
+	if metadata.MinKnVersion != "" {
+		fmt.Fprintf(out, "    minimal kn version: %s\n", metadata.MinKnVersion)
+	}
+	if len(metadata.Subcommands) > 0 {
+		var names []string
+		for _, sub := range metadata.Subcommands {
+			names = append(names, sub.Name)
+		}
+		fmt.Fprintf(out, "    sub-commands: %s\n", strings.Join(names, ", "))
+	}
+	if len(metadata.Flags) > 0 {
+		var names []string
+		for _, flag := range metadata.Flags {
+			names = append(names, "--"+flag.Name)
+		}
+		fmt.Fprintf(out, "    options: %s\n", strings.Join(names, ", "))
+	}
+}
+
 // create an info label which can be appended to an verbose output
 func extraLabelIfPathNotExists(path string) string {
 	_, err := os.Stat(path)
@@ -129,6 +129,31 @@ func TestPluginListExtendingBuiltinCommandGroup(t *testing.T) {
 	assert.Assert(t, !strings.Contains(out, "ERROR"))
 }
 
+func TestPluginListWithMetadata(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts are shell scripts")
+	}
+	pluginDir, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+	script := "#!/bin/sh\necho '" +
+		`{"description": "Manage Kafka sources", "version": "v0.2.0", "subcommands": [{"name": "create"}, {"name": "delete"}], "flags": [{"name": "topic"}]}` +
+		"'\n"
+	err := ioutil.WriteFile(filepath.Join(pluginDir, "kn-kafka"), []byte(script), 0777)
+	assert.NilError(t, err)
+
+	outBuf := bytes.Buffer{}
+	testCmd := cobra.Command{
+		Use: "kn",
+	}
+	testCmd.SetOut(&outBuf)
+	testCmd.AddCommand(&cobra.Command{Use: "children"})
+	err = listPlugins(&testCmd, pluginListFlags{verbose: true})
+	assert.NilError(t, err)
+
+	out := outBuf.String()
+	assert.Assert(t, util.ContainsAll(out, "kn-kafka : Manage Kafka sources", "version: v0.2.0", "sub-commands: create, delete", "options: --topic"))
+}
+
 // Private
 
 func prepareTestSetup(t *testing.T, args ...interface{}) (string, func()) {
@@ -31,6 +31,9 @@ Please refer to the documentation and examples for more information about how to
 	}
 
 	pluginCmd.AddCommand(NewPluginListCommand(p))
+	pluginCmd.AddCommand(NewPluginInstallCommand(p))
+	pluginCmd.AddCommand(NewPluginUninstallCommand(p))
+	pluginCmd.AddCommand(NewPluginUpgradeCommand(p))
 
 	return pluginCmd
 }
@@ -0,0 +1,50 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewPluginUninstallCommand creates a new `kn plugin uninstall` command
+func NewPluginUninstallCommand(p *commands.KnParams) *cobra.Command {
+	pluginUninstallCommand := &cobra.Command{
+		Use:   "uninstall NAME",
+		Short: "Uninstall a plugin",
+		Long: `Uninstall a plugin from kn's plugin directory.
+
+Plugins which are found only in the execution $PATH are not removed.`,
+		Example: `
+  # Uninstall the plugin 'source-kafka'
+  kn plugin uninstall source-kafka`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'plugin uninstall' requires the plugin name given as single argument")
+			}
+			err := newPluginManager().Uninstall(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Plugin '%s' uninstalled.\n", args[0])
+			return nil
+		},
+	}
+	return pluginUninstallCommand
+}
@@ -0,0 +1,43 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestPluginUninstall(t *testing.T) {
+	pluginDir, cleanupFunc := prepareTestSetup(t, "kn-test", 0777)
+	defer cleanupFunc()
+
+	out, err := executePluginCommand("uninstall", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Plugin", "test", "uninstalled"))
+
+	out, err = executePluginCommand("list")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsNone(out, "kn-test"))
+
+	_, err = executePluginCommand("uninstall", "test")
+	assert.ErrorContains(t, err, "not installed")
+	assert.ErrorContains(t, err, pluginDir)
+
+	_, err = executePluginCommand("uninstall")
+	assert.ErrorContains(t, err, "single argument")
+}
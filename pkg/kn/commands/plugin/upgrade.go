@@ -0,0 +1,111 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/plugin"
+)
+
+// pluginUpgradeFlags contains all plugin upgrade flags
+type pluginUpgradeFlags struct {
+	index string
+	all   bool
+}
+
+// NewPluginUpgradeCommand creates a new `kn plugin upgrade` command
+func NewPluginUpgradeCommand(p *commands.KnParams) *cobra.Command {
+	upgradeFlags := pluginUpgradeFlags{}
+	pluginUpgradeCommand := &cobra.Command{
+		Use:   "upgrade NAME|--all",
+		Short: "Upgrade plugins installed from a plugin index",
+		Long: `Upgrade plugins installed from a plugin index to the version listed in the index.
+
+The index from which a plugin has been installed is used unless --index is given.`,
+		Example: `
+  # Upgrade the plugin 'source-kafka'
+  kn plugin upgrade source-kafka
+
+  # Upgrade all plugins which have been installed from a plugin index
+  kn plugin upgrade --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager := newPluginManager()
+			var names []string
+			if upgradeFlags.all {
+				if len(args) > 0 {
+					return errors.New("'plugin upgrade' accepts either a plugin name or --all")
+				}
+				installedPlugins, err := manager.InstalledPlugins()
+				if err != nil {
+					return err
+				}
+				for _, installed := range installedPlugins {
+					names = append(names, installed.Name)
+				}
+				if len(names) == 0 {
+					fmt.Fprintln(cmd.OutOrStdout(), "No plugins installed from a plugin index found.")
+					return nil
+				}
+			} else {
+				if len(args) != 1 {
+					return errors.New("'plugin upgrade' requires the plugin name given as single argument or --all")
+				}
+				names = args
+			}
+			for _, name := range names {
+				err := upgradePlugin(cmd.OutOrStdout(), manager, name, upgradeFlags.index)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	addIndexFlag(pluginUpgradeCommand, &upgradeFlags.index)
+	pluginUpgradeCommand.Flags().BoolVar(&upgradeFlags.all, "all", false, "Upgrade all plugins installed from a plugin index")
+	return pluginUpgradeCommand
+}
+
+func upgradePlugin(out io.Writer, manager *plugin.Manager, name string, indexLocation string) error {
+	if indexLocation == "" {
+		installed, err := manager.InstalledPlugin(name)
+		if err != nil {
+			return err
+		}
+		if installed != nil {
+			indexLocation = installed.Index
+		}
+	}
+	index, err := loadIndex(indexLocation)
+	if err != nil {
+		return err
+	}
+	previous, upgraded, err := manager.Upgrade(index, name)
+	if err != nil {
+		return err
+	}
+	if previous.Version == upgraded.Version {
+		fmt.Fprintf(out, "Plugin '%s' is already up to date (%s).\n", upgraded.Name, upgraded.Version)
+	} else {
+		fmt.Fprintf(out, "Plugin '%s' upgraded from %s to %s.\n", upgraded.Name, previous.Version, upgraded.Version)
+	}
+	return nil
+}
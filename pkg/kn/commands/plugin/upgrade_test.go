@@ -0,0 +1,60 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestPluginUpgrade(t *testing.T) {
+	pluginDir, cleanupFunc := prepareTestSetup(t)
+	defer cleanupFunc()
+	indexFile := writeTestIndex(t, pluginDir, "v0.1.0")
+
+	_, err := executePluginCommand("install", "test", "--index", indexFile)
+	assert.NilError(t, err)
+
+	out, err := executePluginCommand("upgrade", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Plugin", "test", "already up to date", "v0.1.0"))
+
+	// The index used for installation is picked up again
+	writeTestIndex(t, pluginDir, "v0.2.0")
+	out, err = executePluginCommand("upgrade", "--all")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Plugin", "test", "upgraded", "v0.1.0", "v0.2.0"))
+}
+
+func TestPluginUpgradeErrors(t *testing.T) {
+	_, cleanupFunc := prepareTestSetup(t, "kn-test", 0777)
+	defer cleanupFunc()
+
+	out, err := executePluginCommand("upgrade", "--all")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "No plugins"))
+
+	_, err = executePluginCommand("upgrade")
+	assert.ErrorContains(t, err, "--all")
+
+	_, err = executePluginCommand("upgrade", "test", "--all")
+	assert.ErrorContains(t, err, "either")
+
+	_, err = executePluginCommand("upgrade", "test", "--index", "bogus.yaml")
+	assert.ErrorContains(t, err, "cannot read plugin index")
+}
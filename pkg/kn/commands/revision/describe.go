@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"knative.dev/serving/pkg/apis/serving"
@@ -97,11 +98,13 @@ func describe(w io.Writer, revision *servingv1.Revision, service *servingv1.Serv
 	commands.WriteMetadata(dw, &revision.ObjectMeta, printDetails)
 	WriteImage(dw, revision)
 	WritePort(dw, revision)
+	WriteProbes(dw, revision)
 	WriteEnv(dw, revision, printDetails)
 	WriteEnvFrom(dw, revision, printDetails)
 	WriteScale(dw, revision)
 	WriteConcurrencyOptions(dw, revision)
 	WriteResources(dw, revision)
+	WriteSidecars(dw, revision, printDetails)
 	serviceName, ok := revision.Labels[serving.ServiceLabelKey]
 	if ok {
 		serviceSection := dw.WriteAttribute("Service", serviceName)
@@ -188,15 +191,41 @@ func WritePort(dw printers.PrefixWriter, revision *servingv1.Revision) {
 	}
 }
 
+// WriteProbes writes the readiness and liveness probes, if any
+func WriteProbes(dw printers.PrefixWriter, revision *servingv1.Revision) {
+	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
+	if err != nil {
+		return
+	}
+	writeContainerProbes(dw, container)
+}
+
+func writeContainerProbes(dw printers.PrefixWriter, container *corev1.Container) {
+	if container.ReadinessProbe != nil {
+		dw.WriteAttribute("Readiness", clientserving.ProbeDescription(container.ReadinessProbe))
+	}
+	if container.LivenessProbe != nil {
+		dw.WriteAttribute("Liveness", clientserving.ProbeDescription(container.LivenessProbe))
+	}
+}
+
 func WriteEnv(dw printers.PrefixWriter, revision *servingv1.Revision, printDetails bool) {
-	env := stringifyEnv(revision)
+	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
+	if err != nil {
+		return
+	}
+	env := stringifyEnv(container)
 	if env != nil {
 		commands.WriteSliceDesc(dw, env, "Env", printDetails)
 	}
 }
 
 func WriteEnvFrom(dw printers.PrefixWriter, revision *servingv1.Revision, printDetails bool) {
-	envFrom := stringifyEnvFrom(revision)
+	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
+	if err != nil {
+		return
+	}
+	envFrom := stringifyEnvFrom(container)
 	if envFrom != nil {
 		commands.WriteSliceDesc(dw, envFrom, "EnvFrom", printDetails)
 	}
@@ -218,6 +247,37 @@ func WriteResources(dw printers.PrefixWriter, r *servingv1.Revision) {
 	if err != nil {
 		return
 	}
+	writeContainerResources(dw, c)
+}
+
+// WriteSidecars writes a section for every container besides the first one,
+// with its image and, when printing details, its configuration
+func WriteSidecars(dw printers.PrefixWriter, revision *servingv1.Revision, printDetails bool) {
+	if len(revision.Spec.Containers) < 2 {
+		return
+	}
+	for i := range revision.Spec.Containers[1:] {
+		container := &revision.Spec.Containers[i+1]
+		section := dw.WriteAttribute("Sidecar", container.Name)
+		section.WriteAttribute("Image", container.Image)
+		if !printDetails {
+			continue
+		}
+		for _, port := range container.Ports {
+			section.WriteAttribute("Port", strconv.FormatInt(int64(port.ContainerPort), 10))
+		}
+		writeContainerProbes(section, container)
+		if len(container.Env) > 0 {
+			commands.WriteSliceDesc(section, stringifyEnv(container), "Env", printDetails)
+		}
+		if len(container.EnvFrom) > 0 {
+			commands.WriteSliceDesc(section, stringifyEnvFrom(container), "EnvFrom", printDetails)
+		}
+		writeContainerResources(section, container)
+	}
+}
+
+func writeContainerResources(dw printers.PrefixWriter, c *corev1.Container) {
 	requests := c.Resources.Requests
 	limits := c.Resources.Limits
 	writeResourcesHelper(dw, "Memory", requests.Memory(), limits.Memory())
@@ -270,29 +330,16 @@ func formatScale(minScale *int, maxScale *int) string {
 	return ret
 }
 
-func stringifyEnv(revision *servingv1.Revision) []string {
-	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
-	if err != nil {
-		return nil
-	}
-
+func stringifyEnv(container *corev1.Container) []string {
 	envVars := make([]string, 0, len(container.Env))
 	for _, env := range container.Env {
-		value := env.Value
-		if env.ValueFrom != nil {
-			value = "[ref]"
-		}
-		envVars = append(envVars, fmt.Sprintf("%s=%s", env.Name, value))
+		// References are shown as such, the referenced values are never resolved
+		envVars = append(envVars, fmt.Sprintf("%s=%s", env.Name, clientserving.EnvVarValue(env)))
 	}
 	return envVars
 }
 
-func stringifyEnvFrom(revision *servingv1.Revision) []string {
-	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
-	if err != nil {
-		return nil
-	}
-
+func stringifyEnvFrom(container *corev1.Container) []string {
 	var result []string
 	for _, envFromSource := range container.EnvFrom {
 		if envFromSource.ConfigMapRef != nil {
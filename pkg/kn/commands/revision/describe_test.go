@@ -126,6 +126,54 @@ func TestDescribeRevisionBasic(t *testing.T) {
 	assert.Assert(t, util.ContainsAll(data, "EnvFrom:", "cm:test1, cm:test2"))
 }
 
+func TestDescribeRevisionSidecars(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3)
+	expectedRevision.Spec.Containers = append(expectedRevision.Spec.Containers, v1.Container{
+		Name:  "logger",
+		Image: "fluent/bit",
+		Env:   []v1.EnvVar{{Name: "LEVEL", Value: "debug"}},
+	})
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev"}, &expectedRevision)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(data, "Image:", "gcr.io/test/image", "Sidecar:", "logger", "fluent/bit"))
+	assert.Assert(t, util.ContainsNone(data, "LEVEL=debug"))
+
+	_, data, err = fakeRevision([]string{"revision", "describe", "test-rev", "--verbose"}, &expectedRevision)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(data, "Sidecar:", "logger", "fluent/bit", "LEVEL=debug"))
+}
+
+func TestDescribeRevisionEnvReferences(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3)
+	expectedRevision.Spec.Containers[0].Env = append(expectedRevision.Spec.Containers[0].Env,
+		v1.EnvVar{Name: "DB_PASS", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "db"},
+			Key:                  "password",
+		}}},
+		v1.EnvVar{Name: "NS", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}})
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev", "--verbose"}, &expectedRevision)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(data, "env1=eval1", "DB_PASS=secret:db:password", "NS=fieldRef:metadata.namespace"))
+	assert.Assert(t, util.ContainsNone(data, "[ref]"))
+}
+
+func TestDescribeRevisionProbes(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3)
+	expectedRevision.Spec.Containers[0].ReadinessProbe = &v1.Probe{
+		Handler:             v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz"}},
+		InitialDelaySeconds: 5,
+	}
+	expectedRevision.Spec.Containers[0].LivenessProbe = &v1.Probe{
+		Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/healthy"}}},
+	}
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev"}, &expectedRevision)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(data, "Readiness:", "http:/healthz (InitialDelaySeconds=5)", "Liveness:", "exec:cat,/tmp/healthy"))
+}
+
 func createTestRevision(revision string, gen int64) servingv1.Revision {
 	labels := make(map[string]string)
 	labels[apiserving.ConfigurationGenerationLabelKey] = fmt.Sprintf("%d", gen)
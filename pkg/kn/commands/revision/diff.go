@@ -0,0 +1,128 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientserving "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// NewRevisionDiffCommand represents 'revision diff' command
+func NewRevisionDiffCommand(p *commands.KnParams) *cobra.Command {
+	var diffFlags commands.DiffFlags
+
+	command := &cobra.Command{
+		Use:   "diff REVISION_A REVISION_B",
+		Short: "Show the differences between two revisions",
+		Long: `Show the differences between two revisions.
+
+The image, port, environment, mounts, resources, sidecars, autoscaling settings
+and the traffic routed to the revisions are compared.`,
+		Example: `
+  # Show what changed between revision 'svc1-v1' and 'svc1-v2'
+  kn revision diff svc1-v1 svc1-v2
+
+  # Show the differences as JSON
+  kn revision diff svc1-v1 svc1-v2 -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("'kn revision diff' requires the names of two revisions as arguments")
+			}
+			err := diffFlags.Validate()
+			if err != nil {
+				return err
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			from, err := client.GetRevision(args[0])
+			if err != nil {
+				return err
+			}
+			to, err := client.GetRevision(args[1])
+			if err != nil {
+				return err
+			}
+			diffs := clientserving.DiffRevisionTemplates(
+				&servingv1.RevisionTemplateSpec{ObjectMeta: from.ObjectMeta, Spec: from.Spec},
+				&servingv1.RevisionTemplateSpec{ObjectMeta: to.ObjectMeta, Spec: to.Spec})
+
+			trafficDiffs, err := diffRevisionTraffic(client, from, to)
+			if err != nil {
+				return err
+			}
+			diffs = append(diffs, trafficDiffs...)
+			return diffFlags.PrintDiff(cmd.OutOrStdout(), fmt.Sprintf("revision '%s'", from.Name), fmt.Sprintf("revision '%s'", to.Name), diffs)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	diffFlags.Add(command)
+	return command
+}
+
+// diffRevisionTraffic compares the traffic percentage and tags the revisions
+// get from their services
+func diffRevisionTraffic(client clientservingv1.KnServingClient, from *servingv1.Revision, to *servingv1.Revision) ([]clientserving.FieldDiff, error) {
+	services := map[string]*servingv1.Service{}
+	traffic := func(revision *servingv1.Revision) (string, string, error) {
+		serviceName, ok := revision.Labels[serving.ServiceLabelKey]
+		if !ok {
+			return "", "", nil
+		}
+		service, ok := services[serviceName]
+		if !ok {
+			var err error
+			service, err = client.GetService(serviceName)
+			if err != nil {
+				return "", "", err
+			}
+			services[serviceName] = service
+		}
+		percent, tags := trafficAndTagsForRevision(revision.Name, service)
+		return fmt.Sprintf("%d%%", percent), strings.Join(tags, ", "), nil
+	}
+
+	fromPercent, fromTags, err := traffic(from)
+	if err != nil {
+		return nil, err
+	}
+	toPercent, toTags, err := traffic(to)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []clientserving.FieldDiff
+	if fromPercent != toPercent {
+		diffs = append(diffs, clientserving.FieldDiff{Field: "traffic", From: fromPercent, To: toPercent})
+	}
+	if fromTags != toTags {
+		diffs = append(diffs, clientserving.FieldDiff{Field: "tags", From: fromTags, To: toTags})
+	}
+	return diffs, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+	apiserving "knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+)
+
+func fakeRevisionDiff(args []string, objects ...runtime.Object) (string, error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "*",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.GetAction).GetName()
+			for _, obj := range objects {
+				switch o := obj.(type) {
+				case *servingv1.Revision:
+					if a.GetResource().Resource == "revisions" && o.Name == name {
+						return true, o, nil
+					}
+				case *servingv1.Service:
+					if a.GetResource().Resource == "services" && o.Name == name {
+						return true, o, nil
+					}
+				}
+			}
+			return false, nil, nil
+		})
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func TestRevisionDiff(t *testing.T) {
+	revA := diffRevision("foo-v1", "gcr.io/foo/bar:v1", corev1.EnvVar{Name: "A", Value: "1"})
+	revB := diffRevision("foo-v2", "gcr.io/foo/bar:v2", corev1.EnvVar{Name: "A", Value: "2"}, corev1.EnvVar{Name: "B", Value: "3"})
+	service := &servingv1.Service{}
+	service.Name = "foo"
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-v2", Percent: ptr.Int64(100)},
+		{RevisionName: "foo-v1", Tag: "old", Percent: ptr.Int64(0)},
+	}
+
+	output, err := fakeRevisionDiff([]string{"revision", "diff", "foo-v1", "foo-v2"}, revA, revB, service)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Differences between revision 'foo-v1' and revision 'foo-v2'",
+		"~ image", "gcr.io/foo/bar:v1 -> gcr.io/foo/bar:v2",
+		"~ env[A]", "1 -> 2",
+		"+ env[B]", "3",
+		"~ traffic", "0% -> 100%",
+		"- tags", "old"))
+
+	output, err = fakeRevisionDiff([]string{"revision", "diff", "foo-v1", "foo-v2", "-o", "json"}, revA, revB, service)
+	assert.NilError(t, err)
+	result := map[string]interface{}{}
+	assert.NilError(t, json.Unmarshal([]byte(output), &result))
+	assert.Equal(t, result["from"], "revision 'foo-v1'")
+	assert.Equal(t, len(result["changes"].([]interface{})), 5)
+
+	output, err = fakeRevisionDiff([]string{"revision", "diff", "foo-v1", "foo-v1"}, revA, service)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No differences"))
+}
+
+func TestRevisionDiffErrors(t *testing.T) {
+	_, err := fakeRevisionDiff([]string{"revision", "diff", "foo-v1"})
+	assert.ErrorContains(t, err, "requires the names of two revisions")
+
+	_, err = fakeRevisionDiff([]string{"revision", "diff", "foo-v1", "foo-v2", "-o", "yaml"})
+	assert.ErrorContains(t, err, "only 'json' is supported")
+}
+
+func diffRevision(name string, image string, env ...corev1.EnvVar) *servingv1.Revision {
+	revision := &servingv1.Revision{}
+	revision.Name = name
+	revision.Labels = map[string]string{apiserving.ServiceLabelKey: "foo"}
+	revision.Spec.Containers = []corev1.Container{{Image: image, Env: env}}
+	return revision
+}
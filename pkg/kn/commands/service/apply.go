@@ -0,0 +1,172 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+var applyExample = `
+  # Create an initial service with using 'kn service apply', if the service has not
+  # been already created
+  kn service apply s0 --image knativesamples/helloworld
+
+  # Apply the service again which is a no-operation if none of the options changed
+  kn service apply s0 --image knativesamples/helloworld
+
+  # Add an environment variable to your service. Note, you have to always fully
+  # specify all parameters (in contrast to 'update')
+  kn service apply s0 --image knativesamples/helloworld --env foo=bar
+
+  # Read the service declaration from a file
+  kn service apply -f service.yaml
+
+  # Read the service declaration from a file and override the image
+  kn service apply -f service.yaml --image knativesamples/helloworld:v2`
+
+// NewServiceApplyCommand returns a new command for declaratively applying a service
+func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags ConfigurationEditFlags
+	var waitFlags commands.WaitFlags
+	var filename string
+
+	serviceApplyCommand := &cobra.Command{
+		Use:     "apply [NAME] [--image IMAGE | --filename FILE]",
+		Short:   "Apply a service declaration",
+		Example: applyExample,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) > 1 {
+				return errors.New("'service apply' accepts the service name as single argument only")
+			}
+			if len(args) == 0 && filename == "" {
+				return errors.New("'service apply' requires the service name given as single argument")
+			}
+			if filename == "" && editFlags.Image == "" {
+				return errors.New("'service apply' requires the image name to run provided with the --image option or a service declaration provided with --filename")
+			}
+			name := ""
+			if len(args) == 1 {
+				name = args[0]
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			// A generated revision name would change the template on each call
+			if !cmd.Flags().Changed("revision-name") {
+				editFlags.RevisionName = ""
+			}
+
+			var service *servingv1.Service
+			if filename != "" {
+				service, err = constructServiceFromFile(cmd, editFlags, name, namespace, filename)
+			} else {
+				service, err = constructService(cmd, editFlags, name, namespace)
+			}
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			changed, err := client.ApplyService(service)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !changed {
+				fmt.Fprintf(out, "Service '%s' in namespace '%s' is unchanged.\n", service.Name, namespace)
+				return nil
+			}
+			return waitIfRequested(client, service, waitFlags, "Applying", "applied", out)
+		},
+	}
+	commands.AddNamespaceFlags(serviceApplyCommand.Flags(), false)
+	editFlags.AddApplyFlags(serviceApplyCommand)
+	serviceApplyCommand.Flags().StringVarP(&filename, "filename", "f", "",
+		"Service declaration in YAML or JSON format to apply. Options given on the command line are applied on top of it.")
+	waitFlags.AddConditionWaitFlags(serviceApplyCommand, commands.WaitDefaultTimeout, "apply", "service", "ready")
+	return serviceApplyCommand
+}
+
+// constructServiceFromFile reads a service declaration from the given file
+// and applies the options given on the command line
+func constructServiceFromFile(cmd *cobra.Command, editFlags ConfigurationEditFlags, name string, namespace string, filename string) (*servingv1.Service, error) {
+	service, err := readServiceFromFile(filename, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the revision name from the declaration if not overridden
+	if !cmd.Flags().Changed("revision-name") {
+		editFlags.RevisionName = service.Spec.Template.Name
+	}
+	err = editFlags.Apply(service, nil, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// readServiceFromFile reads a service declaration in YAML or JSON format and
+// validates its name and namespace
+func readServiceFromFile(filename string, name string, namespace string) (*servingv1.Service, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	service := servingv1.Service{}
+	err = yaml.Unmarshal(content, &service)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse service declaration in '%s': %v", filename, err)
+	}
+	if service.Kind != "" && service.Kind != "Service" {
+		return nil, fmt.Errorf("'%s' contains a '%s' but only 'Service' can be applied", filename, service.Kind)
+	}
+
+	if name != "" {
+		if service.Name != "" && service.Name != name {
+			return nil, fmt.Errorf("provided service name '%s' doesn't match name '%s' in '%s'", name, service.Name, filename)
+		}
+		service.Name = name
+	}
+	if service.Name == "" {
+		return nil, fmt.Errorf("no service name given as argument or found in '%s'", filename)
+	}
+	if service.Namespace != "" && service.Namespace != namespace {
+		return nil, fmt.Errorf("namespace '%s' in '%s' doesn't match the target namespace '%s'", service.Namespace, filename, namespace)
+	}
+	service.Namespace = namespace
+	if len(service.Spec.Template.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("service declaration in '%s' doesn't contain a container", filename)
+	}
+	return &service, nil
+}
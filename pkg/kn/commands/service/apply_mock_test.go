@@ -0,0 +1,101 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceApplyCreateMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.ApplyService(func(t *testing.T, a interface{}) {
+		svc := a.(*servingv1.Service)
+		assert.Equal(t, svc.Name, "foo")
+		assert.Equal(t, svc.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:baz")
+		// No generated revision name, so that applying again is idempotent
+		assert.Equal(t, svc.Spec.Template.Name, "")
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:baz")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Applying", "foo", "http://foo.example.com", "applied"))
+
+	r.Validate()
+}
+
+func TestServiceApplyUnchangedMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.ApplyService(mock.Any(), false, nil)
+
+	output, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:baz")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo", "unchanged"))
+
+	r.Validate()
+}
+
+func TestServiceApplyFromFileMock(t *testing.T) {
+	svc := getService("foo")
+	svc.Spec.Template.Name = "foo-v1"
+	svc.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v1"
+	file := writeImportObject(t, svc)
+	defer os.RemoveAll(filepath.Dir(file))
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.ApplyService(func(t *testing.T, a interface{}) {
+		svc := a.(*servingv1.Service)
+		assert.Equal(t, svc.Name, "foo")
+		assert.Equal(t, svc.Spec.Template.Name, "foo-v1")
+		assert.Equal(t, svc.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+	}, true, nil)
+
+	output, err := executeServiceCommand(client, "apply", "-f", file, "--image", "gcr.io/foo/bar:v2", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo", "applied"))
+
+	r.Validate()
+}
+
+func TestServiceApplyErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "apply", "foo")
+	assert.ErrorContains(t, err, "--image")
+
+	_, err = executeServiceCommand(client, "apply", "--image", "gcr.io/foo/bar:baz")
+	assert.ErrorContains(t, err, "service name")
+
+	file := writeImportObject(t, getService("foo"))
+	defer os.RemoveAll(filepath.Dir(file))
+	_, err = executeServiceCommand(client, "apply", "bar", "-f", file)
+	assert.ErrorContains(t, err, "doesn't match")
+}
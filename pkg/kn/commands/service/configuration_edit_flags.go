@@ -17,6 +17,8 @@ package service
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -25,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"knative.dev/client/pkg/kn/config"
 	knflags "knative.dev/client/pkg/kn/flags"
 	servinglib "knative.dev/client/pkg/serving"
 	"knative.dev/client/pkg/util"
@@ -34,15 +37,20 @@ import (
 
 type ConfigurationEditFlags struct {
 	// Direct field manipulation
-	Image   uniqueStringArg
-	Env     []string
-	EnvFrom []string
-	Mount   []string
-	Volume  []string
+	Image        uniqueStringArg
+	Env          []string
+	EnvValueFrom []string
+	EnvFile      []string
+	EnvFrom      []string
+	Mount        []string
+	Volume       []string
 
 	Command string
 	Arg     []string
 
+	Container string
+	Sidecars  []string
+
 	RequestsFlags, LimitsFlags ResourceFlags // TODO: Flag marked deprecated in release v0.15.0, remove in release v0.18.0
 	Resources                  knflags.ResourceOptions
 	MinScale                   int
@@ -62,6 +70,10 @@ type ConfigurationEditFlags struct {
 	Annotations                []string
 	ClusterLocal               bool
 	User                       int64
+	ReadinessProbe             string
+	ReadinessProbeOpts         []string
+	LivenessProbe              string
+	LivenessProbeOpts          []string
 
 	// Preferences about how to do the action.
 	LockToDigest         bool
@@ -102,8 +114,9 @@ func (p *ConfigurationEditFlags) markFlagMakesRevision(f string) {
 	p.flags = append(p.flags, f)
 }
 
-// addSharedFlags adds the flags common between create & update.
-func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
+// AddContainerFlags adds the flags for configuring the container. These
+// flags are shared with other resources running a pod template, like sources.
+func (p *ConfigurationEditFlags) AddContainerFlags(command *cobra.Command) {
 	command.Flags().VarP(&p.Image, "image", "", "Image to run.")
 	p.markFlagMakesRevision("image")
 	command.Flags().StringArrayVarP(&p.Env, "env", "e", []string{},
@@ -112,6 +125,20 @@ func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
 			"To unset, specify the environment variable name followed by a \"-\" (e.g., NAME-).")
 	p.markFlagMakesRevision("env")
 
+	command.Flags().StringArrayVarP(&p.EnvValueFrom, "env-value-from", "", []string{},
+		"Environment variable taking its value from a key of a Secret (secret:NAME:KEY) or a ConfigMap "+
+			"(cm:NAME:KEY or config-map:NAME:KEY), or from a field of the pod (fieldRef:PATH). "+
+			"Example: --env-value-from DB_PASS=secret:db:password or --env-value-from NS=fieldRef:metadata.namespace. "+
+			"You can use this flag multiple times. "+
+			"To unset, specify the environment variable name followed by a \"-\" (e.g., NAME-).")
+	p.markFlagMakesRevision("env-value-from")
+
+	command.Flags().StringArrayVarP(&p.EnvFile, "env-file", "", []string{},
+		"Path to a file with environment variables to set, one NAME=value per line. "+
+			"Empty lines and lines starting with '#' are ignored. "+
+			"Variables given with --env take precedence over the ones from the file.")
+	p.markFlagMakesRevision("env-file")
+
 	command.Flags().StringArrayVarP(&p.EnvFrom, "env-from", "", []string{},
 		"Add environment variables from a ConfigMap (prefix cm: or config-map:) or a Secret (prefix secret:). "+
 			"Example: --env-from cm:myconfigmap or --env-from secret:mysecret. "+
@@ -144,6 +171,11 @@ func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
 			"Example: --arg myArg1 --arg --myArg2 --arg myArg3=3. "+
 			"You can use this flag multiple times.")
 	p.markFlagMakesRevision("arg")
+}
+
+// addSharedFlags adds the flags common between create & update.
+func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
+	p.AddContainerFlags(command)
 
 	command.Flags().StringSliceVar(&p.Resources.Limits,
 		"limit",
@@ -229,14 +261,23 @@ func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
 			"precedence over \"label\" flag.")
 	p.markFlagMakesRevision("label-revision")
 
-	command.Flags().StringVar(&p.RevisionName, "revision-name", "{{.Service}}-{{.Random 5}}-{{.Generation}}",
+	revisionNameDefault, lockToDigestDefault := "{{.Service}}-{{.Random 5}}-{{.Generation}}", true
+	if profile := config.GlobalConfig.Profile(); profile != nil {
+		if profile.RevisionName != nil {
+			revisionNameDefault = *profile.RevisionName
+		}
+		if profile.LockToDigest != nil {
+			lockToDigestDefault = *profile.LockToDigest
+		}
+	}
+	command.Flags().StringVar(&p.RevisionName, "revision-name", revisionNameDefault,
 		"The revision name to set. Must start with the service name and a dash as a prefix. "+
 			"Empty revision name will result in the server generating a name for the revision. "+
 			"Accepts golang templates, allowing {{.Service}} for the service name, "+
 			"{{.Generation}} for the generation, and {{.Random [n]}} for n random consonants.")
 	p.markFlagMakesRevision("revision-name")
 
-	knflags.AddBothBoolFlagsUnhidden(command.Flags(), &p.LockToDigest, "lock-to-digest", "", true,
+	knflags.AddBothBoolFlagsUnhidden(command.Flags(), &p.LockToDigest, "lock-to-digest", "", lockToDigestDefault,
 		"Keep the running image for the service constant when not explicitly specifying "+
 			"the image. (--no-lock-to-digest pulls the image tag afresh with each new revision)")
 	// Don't mark as changing the revision.
@@ -260,6 +301,40 @@ func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
 	p.markFlagMakesRevision("pull-secret")
 	command.Flags().Int64VarP(&p.User, "user", "", 0, "The user ID to run the container (e.g., 1001).")
 	p.markFlagMakesRevision("user")
+
+	command.Flags().StringVar(&p.ReadinessProbe, "probe-readiness", "",
+		"Readiness probe of the container, one of http:PATH[:PORT], tcp:PORT or exec:CMD[,ARG...]. "+
+			"Example: --probe-readiness http:/healthz:8080 or --probe-readiness \"exec:sh,-c,test -f /tmp/ready\". "+
+			"To remove the probe, specify \"-\" (e.g., --probe-readiness -).")
+	p.markFlagMakesRevision("probe-readiness")
+
+	command.Flags().StringSliceVar(&p.ReadinessProbeOpts, "probe-readiness-opts", nil,
+		"Options of the readiness probe, any of InitialDelaySeconds, PeriodSeconds, TimeoutSeconds and FailureThreshold. "+
+			"Example: --probe-readiness-opts InitialDelaySeconds=5,PeriodSeconds=10")
+	p.markFlagMakesRevision("probe-readiness-opts")
+
+	command.Flags().StringVar(&p.LivenessProbe, "probe-liveness", "",
+		"Liveness probe of the container, one of http:PATH[:PORT], tcp:PORT or exec:CMD[,ARG...]. "+
+			"Example: --probe-liveness tcp:8080. "+
+			"To remove the probe, specify \"-\" (e.g., --probe-liveness -).")
+	p.markFlagMakesRevision("probe-liveness")
+
+	command.Flags().StringSliceVar(&p.LivenessProbeOpts, "probe-liveness-opts", nil,
+		"Options of the liveness probe, any of InitialDelaySeconds, PeriodSeconds, TimeoutSeconds and FailureThreshold. "+
+			"Example: --probe-liveness-opts PeriodSeconds=30,FailureThreshold=5")
+	p.markFlagMakesRevision("probe-liveness-opts")
+
+	command.Flags().StringVar(&p.Container, "container", "",
+		"Name of the container to which --image, --env, --env-from, --mount, --cmd, --arg, --user, the "+
+			"probe and the resource flags apply. Defaults to the first container. --port can only be set for the "+
+			"first container, as requests are routed to the container which declares the port.")
+	// Don't mark as changing the revision.
+
+	command.Flags().StringArrayVarP(&p.Sidecars, "sidecar", "", []string{},
+		"Add a sidecar container or update its image. NAME=IMAGE; you may provide this flag "+
+			"any number of times to set multiple sidecars. "+
+			"To remove a sidecar, specify its name followed by a \"-\" (e.g., NAME-).")
+	p.markFlagMakesRevision("sidecar")
 }
 
 // AddUpdateFlags adds the flags specific to update.
@@ -267,6 +342,11 @@ func (p *ConfigurationEditFlags) AddUpdateFlags(command *cobra.Command) {
 	p.addSharedFlags(command)
 }
 
+// AddApplyFlags adds the flags specific to apply
+func (p *ConfigurationEditFlags) AddApplyFlags(command *cobra.Command) {
+	p.addSharedFlags(command)
+}
+
 // AddCreateFlags adds the flags specific to create
 func (p *ConfigurationEditFlags) AddCreateFlags(command *cobra.Command) {
 	p.addSharedFlags(command)
@@ -282,84 +362,165 @@ func (p *ConfigurationEditFlags) Apply(
 	cmd *cobra.Command) error {
 
 	template := &service.Spec.Template
-	if cmd.Flags().Changed("env") {
-		envMap, err := util.MapFromArrayAllowingSingles(p.Env, "=")
+	if cmd.Flags().Changed("sidecar") {
+		sidecarsToUpdate, sidecarsToRemove, err := util.OrderedMapAndRemovalListFromArray(p.Sidecars, "=")
 		if err != nil {
-			return fmt.Errorf("Invalid --env: %w", err)
+			return fmt.Errorf("Invalid --sidecar: %w", err)
 		}
-
-		envToRemove := util.ParseMinusSuffix(envMap)
-		err = servinglib.UpdateEnvVars(template, envMap, envToRemove)
+		err = servinglib.UpdateSidecars(template, sidecarsToUpdate, sidecarsToRemove)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("env-from") {
-		envFromSourceToUpdate := []string{}
-		envFromSourceToRemove := []string{}
-		for _, name := range p.EnvFrom {
-			if name == "-" {
-				return fmt.Errorf("\"-\" is not a valid value for \"--env-from\"")
-			} else if strings.HasSuffix(name, "-") {
-				envFromSourceToRemove = append(envFromSourceToRemove, name[:len(name)-1])
-			} else {
-				envFromSourceToUpdate = append(envFromSourceToUpdate, name)
+	containerIndex, err := servinglib.ContainerIndex(&template.Spec, p.Container)
+	if err != nil {
+		return err
+	}
+	if containerIndex != 0 && cmd.Flags().Changed("port") {
+		return fmt.Errorf("--port can't be set for sidecar container %q, only the first container receives requests", p.Container)
+	}
+	err = applyToContainer(template, containerIndex, func(template *servingv1.RevisionTemplateSpec) error {
+		return p.applyContainer(template, cmd)
+	})
+	if err != nil {
+		return err
+	}
+
+	name, err := servinglib.GenerateRevisionName(p.RevisionName, service)
+	if err != nil {
+		return err
+	}
+
+	if p.AnyMutation(cmd) {
+		template.Name = name
+	}
+
+	// Only the image of the first container is tracked and frozen to its digest
+	imageSet := cmd.Flags().Changed("image") && containerIndex == 0
+	_, userImagePresent := template.Annotations[servinglib.UserImageAnnotationKey]
+	freezeMode := userImagePresent || cmd.Flags().Changed("lock-to-digest")
+	if p.LockToDigest && p.AnyMutation(cmd) && freezeMode {
+		servinglib.SetUserImageAnnot(template)
+		if !imageSet {
+			err = servinglib.FreezeImageToDigest(template, baseRevision)
+			if err != nil {
+				return err
 			}
 		}
+	} else if !p.LockToDigest {
+		servinglib.UnsetUserImageAnnot(template)
+	}
 
-		err := servinglib.UpdateEnvFrom(template, envFromSourceToUpdate, envFromSourceToRemove)
+	if cmd.Flags().Changed("min-scale") {
+		err = servinglib.UpdateMinScale(template, p.MinScale)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("mount") || cmd.Flags().Changed("volume") {
-		mountsToUpdate, mountsToRemove, err := util.OrderedMapAndRemovalListFromArray(p.Mount, "=")
+	if cmd.Flags().Changed("max-scale") {
+		err = servinglib.UpdateMaxScale(template, p.MaxScale)
 		if err != nil {
-			return fmt.Errorf("Invalid --mount: %w", err)
+			return err
 		}
+	}
 
-		volumesToUpdate, volumesToRemove, err := util.OrderedMapAndRemovalListFromArray(p.Volume, "=")
+	if cmd.Flags().Changed("autoscale-window") {
+		err = servinglib.UpdateAutoscaleWindow(template, p.AutoscaleWindow)
 		if err != nil {
-			return fmt.Errorf("Invalid --volume: %w", err)
+			return err
 		}
+	}
 
-		err = servinglib.UpdateVolumeMountsAndVolumes(template, mountsToUpdate, mountsToRemove, volumesToUpdate, volumesToRemove)
+	if cmd.Flags().Changed("concurrency-target") {
+		err = servinglib.UpdateConcurrencyTarget(template, p.ConcurrencyTarget)
 		if err != nil {
 			return err
 		}
 	}
 
-	name, err := servinglib.GenerateRevisionName(p.RevisionName, service)
-	if err != nil {
-		return err
+	if cmd.Flags().Changed("concurrency-limit") {
+		err = servinglib.UpdateConcurrencyLimit(template, int64(p.ConcurrencyLimit))
+		if err != nil {
+			return err
+		}
 	}
 
-	if p.AnyMutation(cmd) {
-		template.Name = name
+	if cmd.Flags().Changed("concurrency-utilization") {
+		err = servinglib.UpdateConcurrencyUtilization(template, p.ConcurrencyUtilization)
+		if err != nil {
+			return err
+		}
 	}
 
-	imageSet := false
-	if cmd.Flags().Changed("image") {
-		err = servinglib.UpdateImage(template, p.Image.String())
+	if cmd.Flags().Changed("cluster-local") || cmd.Flags().Changed("no-cluster-local") {
+		if p.ClusterLocal {
+			labels := servinglib.UpdateLabels(service.ObjectMeta.Labels, map[string]string{serving.VisibilityLabelKey: serving.VisibilityClusterLocal}, []string{})
+			service.ObjectMeta.Labels = labels // In case service.ObjectMeta.Labels was nil
+		} else {
+			labels := servinglib.UpdateLabels(service.ObjectMeta.Labels, map[string]string{}, []string{serving.VisibilityLabelKey})
+			service.ObjectMeta.Labels = labels // In case service.ObjectMeta.Labels was nil
+		}
+	}
+
+	if cmd.Flags().Changed("label") || cmd.Flags().Changed("label-service") || cmd.Flags().Changed("label-revision") {
+		labelsAllMap, err := util.MapFromArrayAllowingSingles(p.Labels, "=")
+		if err != nil {
+			return fmt.Errorf("Invalid --label: %w", err)
+		}
+
+		err = p.updateLabels(&service.ObjectMeta, p.LabelsService, labelsAllMap)
+		if err != nil {
+			return fmt.Errorf("Invalid --label-service: %w", err)
+		}
+
+		err = p.updateLabels(&template.ObjectMeta, p.LabelsRevision, labelsAllMap)
+		if err != nil {
+			return fmt.Errorf("Invalid --label-revision: %w", err)
+		}
+	}
+
+	if cmd.Flags().Changed("annotation") {
+		annotationsMap, err := util.MapFromArrayAllowingSingles(p.Annotations, "=")
+		if err != nil {
+			return fmt.Errorf("Invalid --annotation: %w", err)
+		}
+
+		annotationsToRemove := util.ParseMinusSuffix(annotationsMap)
+		err = servinglib.UpdateAnnotations(service, template, annotationsMap, annotationsToRemove)
 		if err != nil {
 			return err
 		}
-		imageSet = true
 	}
-	_, userImagePresent := template.Annotations[servinglib.UserImageAnnotationKey]
-	freezeMode := userImagePresent || cmd.Flags().Changed("lock-to-digest")
-	if p.LockToDigest && p.AnyMutation(cmd) && freezeMode {
-		servinglib.SetUserImageAnnot(template)
-		if !imageSet {
-			err = servinglib.FreezeImageToDigest(template, baseRevision)
-			if err != nil {
-				return err
-			}
+
+	if cmd.Flags().Changed("service-account") {
+		err = servinglib.UpdateServiceAccountName(template, p.ServiceAccountName)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Flags().Changed("pull-secret") {
+		servinglib.UpdateImagePullSecrets(template, p.ImagePullSecrets)
+	}
+
+	return nil
+}
+
+// applyContainer applies the flags which configure a single container to the
+// first container of the given template
+func (p *ConfigurationEditFlags) applyContainer(template *servingv1.RevisionTemplateSpec, cmd *cobra.Command) error {
+	err := p.applyEnvAndVolumes(template, cmd)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("image") {
+		err = servinglib.UpdateImage(template, p.Image.String())
+		if err != nil {
+			return err
 		}
-	} else if !p.LockToDigest {
-		servinglib.UnsetUserImageAnnot(template)
 	}
 
 	if cmd.Flags().Changed("limits-cpu") || cmd.Flags().Changed("limits-memory") {
@@ -420,106 +581,218 @@ func (p *ConfigurationEditFlags) Apply(
 		}
 	}
 
-	if cmd.Flags().Changed("min-scale") {
-		err = servinglib.UpdateMinScale(template, p.MinScale)
-		if err != nil {
-			return err
-		}
+	if cmd.Flags().Changed("user") {
+		servinglib.UpdateUser(template, p.User)
 	}
 
-	if cmd.Flags().Changed("max-scale") {
-		err = servinglib.UpdateMaxScale(template, p.MaxScale)
+	if cmd.Flags().Changed("probe-readiness") || cmd.Flags().Changed("probe-readiness-opts") {
+		options, err := util.MapFromArray(p.ReadinessProbeOpts, "=")
+		if err != nil {
+			return fmt.Errorf("Invalid --probe-readiness-opts: %w", err)
+		}
+		err = servinglib.UpdateReadinessProbe(template, p.ReadinessProbe, options)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("autoscale-window") {
-		err = servinglib.UpdateAutoscaleWindow(template, p.AutoscaleWindow)
+	if cmd.Flags().Changed("probe-liveness") || cmd.Flags().Changed("probe-liveness-opts") {
+		options, err := util.MapFromArray(p.LivenessProbeOpts, "=")
+		if err != nil {
+			return fmt.Errorf("Invalid --probe-liveness-opts: %w", err)
+		}
+		err = servinglib.UpdateLivenessProbe(template, p.LivenessProbe, options)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("concurrency-target") {
-		err = servinglib.UpdateConcurrencyTarget(template, p.ConcurrencyTarget)
+	return nil
+}
+
+// applyToContainer calls update with the container at the given index moved
+// to the front of the template, as the update functions always change the
+// first container. The original order is restored afterwards.
+func applyToContainer(template *servingv1.RevisionTemplateSpec, index int, update func(*servingv1.RevisionTemplateSpec) error) error {
+	if index == 0 {
+		return update(template)
+	}
+	containers := template.Spec.Containers
+	containers[0], containers[index] = containers[index], containers[0]
+	defer func() {
+		containers[0], containers[index] = containers[index], containers[0]
+	}()
+	return update(template)
+}
+
+// ApplyToPodTemplate applies the values of the container flags to the given
+// pod template. Only the flags added with AddContainerFlags are considered.
+func (p *ConfigurationEditFlags) ApplyToPodTemplate(podTemplate *corev1.PodTemplateSpec, cmd *cobra.Command) error {
+	if len(podTemplate.Spec.Containers) == 0 {
+		podTemplate.Spec.Containers = []corev1.Container{{}}
+	}
+	// Reuse the revision template based update functions on the pod spec
+	template := &servingv1.RevisionTemplateSpec{
+		Spec: servingv1.RevisionSpec{PodSpec: podTemplate.Spec},
+	}
+	err := p.applyEnvAndVolumes(template, cmd)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("image") {
+		err = servinglib.UpdateImage(template, p.Image.String())
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("concurrency-limit") {
-		err = servinglib.UpdateConcurrencyLimit(template, int64(p.ConcurrencyLimit))
+	if cmd.Flags().Changed("cmd") {
+		err = servinglib.UpdateContainerCommand(template, p.Command)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("concurrency-utilization") {
-		err = servinglib.UpdateConcurrencyUtilization(template, p.ConcurrencyUtilization)
+	if cmd.Flags().Changed("arg") {
+		err = servinglib.UpdateContainerArg(template, p.Arg)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("cluster-local") || cmd.Flags().Changed("no-cluster-local") {
-		if p.ClusterLocal {
-			labels := servinglib.UpdateLabels(service.ObjectMeta.Labels, map[string]string{serving.VisibilityLabelKey: serving.VisibilityClusterLocal}, []string{})
-			service.ObjectMeta.Labels = labels // In case service.ObjectMeta.Labels was nil
-		} else {
-			labels := servinglib.UpdateLabels(service.ObjectMeta.Labels, map[string]string{}, []string{serving.VisibilityLabelKey})
-			service.ObjectMeta.Labels = labels // In case service.ObjectMeta.Labels was nil
-		}
-	}
+	podTemplate.Spec = template.Spec.PodSpec
+	return nil
+}
 
-	if cmd.Flags().Changed("label") || cmd.Flags().Changed("label-service") || cmd.Flags().Changed("label-revision") {
-		labelsAllMap, err := util.MapFromArrayAllowingSingles(p.Labels, "=")
-		if err != nil {
-			return fmt.Errorf("Invalid --label: %w", err)
+// applyEnvAndVolumes applies the environment and volume related flags
+func (p *ConfigurationEditFlags) applyEnvAndVolumes(template *servingv1.RevisionTemplateSpec, cmd *cobra.Command) error {
+	// The flag which sets or removes each environment variable, so that
+	// --env-value-from can reject conflicting variables
+	envFlags := map[string]string{}
+	if cmd.Flags().Changed("env") || cmd.Flags().Changed("env-file") {
+		envMap := map[string]string{}
+		for _, file := range p.EnvFile {
+			fileEnvMap, err := readEnvFile(file)
+			if err != nil {
+				return fmt.Errorf("Invalid --env-file: %w", err)
+			}
+			util.StringMap(envMap).Merge(fileEnvMap)
+			for name := range fileEnvMap {
+				envFlags[name] = "--env-file"
+			}
+		}
+		for _, env := range p.Env {
+			envFlags[strings.TrimSuffix(strings.SplitN(env, "=", 2)[0], "-")] = "--env"
 		}
 
-		err = p.updateLabels(&service.ObjectMeta, p.LabelsService, labelsAllMap)
+		flagEnvMap, err := util.MapFromArrayAllowingSingles(p.Env, "=")
 		if err != nil {
-			return fmt.Errorf("Invalid --label-service: %w", err)
+			return fmt.Errorf("Invalid --env: %w", err)
 		}
 
-		err = p.updateLabels(&template.ObjectMeta, p.LabelsRevision, labelsAllMap)
+		envToRemove := util.ParseMinusSuffix(flagEnvMap)
+		util.StringMap(envMap).Merge(flagEnvMap)
+		err = servinglib.UpdateEnvVars(template, envMap, envToRemove)
 		if err != nil {
-			return fmt.Errorf("Invalid --label-revision: %w", err)
+			return err
 		}
 	}
 
-	if cmd.Flags().Changed("annotation") {
-		annotationsMap, err := util.MapFromArrayAllowingSingles(p.Annotations, "=")
+	if cmd.Flags().Changed("env-value-from") {
+		envValueFromMap, err := util.MapFromArrayAllowingSingles(p.EnvValueFrom, "=")
 		if err != nil {
-			return fmt.Errorf("Invalid --annotation: %w", err)
+			return fmt.Errorf("Invalid --env-value-from: %w", err)
 		}
 
-		annotationsToRemove := util.ParseMinusSuffix(annotationsMap)
-		err = servinglib.UpdateAnnotations(service, template, annotationsMap, annotationsToRemove)
+		// Compare the names without a trailing "-", so that a variable can't be
+		// set with one flag and removed with the other
+		for _, envValueFrom := range p.EnvValueFrom {
+			name := strings.TrimSuffix(strings.SplitN(envValueFrom, "=", 2)[0], "-")
+			if flag, ok := envFlags[name]; ok {
+				return fmt.Errorf("environment variable %q can't be set with both %s and --env-value-from", name, flag)
+			}
+		}
+		envToRemove := util.ParseMinusSuffix(envValueFromMap)
+		err = servinglib.UpdateEnvValueFrom(template, envValueFromMap, envToRemove)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("service-account") {
-		err = servinglib.UpdateServiceAccountName(template, p.ServiceAccountName)
+	if cmd.Flags().Changed("env-from") {
+		envFromSourceToUpdate := []string{}
+		envFromSourceToRemove := []string{}
+		for _, name := range p.EnvFrom {
+			if name == "-" {
+				return fmt.Errorf("\"-\" is not a valid value for \"--env-from\"")
+			} else if strings.HasSuffix(name, "-") {
+				envFromSourceToRemove = append(envFromSourceToRemove, name[:len(name)-1])
+			} else {
+				envFromSourceToUpdate = append(envFromSourceToUpdate, name)
+			}
+		}
+
+		err := servinglib.UpdateEnvFrom(template, envFromSourceToUpdate, envFromSourceToRemove)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("pull-secret") {
-		servinglib.UpdateImagePullSecrets(template, p.ImagePullSecrets)
-	}
+	if cmd.Flags().Changed("mount") || cmd.Flags().Changed("volume") {
+		mountsToUpdate, mountsToRemove, err := util.OrderedMapAndRemovalListFromArray(p.Mount, "=")
+		if err != nil {
+			return fmt.Errorf("Invalid --mount: %w", err)
+		}
 
-	if cmd.Flags().Changed("user") {
-		servinglib.UpdateUser(template, p.User)
+		volumesToUpdate, volumesToRemove, err := util.OrderedMapAndRemovalListFromArray(p.Volume, "=")
+		if err != nil {
+			return fmt.Errorf("Invalid --volume: %w", err)
+		}
+
+		err = servinglib.UpdateVolumeMountsAndVolumes(template, mountsToUpdate, mountsToRemove, volumesToUpdate, volumesToRemove)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// readEnvFile reads environment variables from a file in the dotenv format.
+// Values may be enclosed in single or double quotes, the latter supporting
+// Go escape sequences.
+func readEnvFile(path string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	envMap := map[string]string{}
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+		pair := strings.SplitN(line, "=", 2)
+		name := strings.TrimSpace(pair[0])
+		if len(pair) != 2 || name == "" {
+			return nil, fmt.Errorf("%s:%d: expected NAME=value, got %q", path, i+1, line)
+		}
+		value := strings.TrimSpace(pair[1])
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			value, err = strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid quoted value for %s: %w", path, i+1, name, err)
+			}
+		} else if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = value[1 : len(value)-1]
+		}
+		envMap[name] = value
+	}
+	return envMap, nil
+}
+
 func (p *ConfigurationEditFlags) updateLabels(obj *metav1.ObjectMeta, flagLabels []string, labelsAllMap map[string]string) error {
 	labelFlagMap, err := util.MapFromArrayAllowingSingles(flagLabels, "=")
 	if err != nil {
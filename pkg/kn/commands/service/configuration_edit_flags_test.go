@@ -0,0 +1,80 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/kn/config"
+)
+
+func TestConfigurationEditFlagsProfileDefaults(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	editFlags := &ConfigurationEditFlags{}
+	editFlags.AddCreateFlags(&cobra.Command{})
+	assert.Equal(t, editFlags.RevisionName, "{{.Service}}-{{.Random 5}}-{{.Generation}}")
+	assert.Equal(t, editFlags.LockToDigest, true)
+
+	revisionName, lockToDigest := "{{.Service}}-{{.Generation}}", false
+	config.GlobalConfig = &config.TestConfig{TestProfile: &config.Profile{
+		RevisionName: &revisionName,
+		LockToDigest: &lockToDigest,
+	}}
+	editFlags = &ConfigurationEditFlags{}
+	editFlags.AddCreateFlags(&cobra.Command{})
+	assert.Equal(t, editFlags.RevisionName, "{{.Service}}-{{.Generation}}")
+	assert.Equal(t, editFlags.LockToDigest, false)
+}
+
+func TestReadEnvFile(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "kn-env-file")
+	assert.NilError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	envFile := filepath.Join(tempDir, ".env")
+	content := "# database settings\n" +
+		"DB_HOST=localhost\n" +
+		"\n" +
+		"export DB_PORT = 5432\n" +
+		"GREETING=\"hello\\nworld\"\n" +
+		"RAW='a \\n b'\n" +
+		"EMPTY=\r\n"
+	assert.NilError(t, ioutil.WriteFile(envFile, []byte(content), 0600))
+
+	envMap, err := readEnvFile(envFile)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, envMap, map[string]string{
+		"DB_HOST":  "localhost",
+		"DB_PORT":  "5432",
+		"GREETING": "hello\nworld",
+		"RAW":      "a \\n b",
+		"EMPTY":    "",
+	})
+
+	assert.NilError(t, ioutil.WriteFile(envFile, []byte("A=1\nnovalue\n"), 0600))
+	_, err = readEnvFile(envFile)
+	assert.ErrorContains(t, err, ".env:2: expected NAME=value")
+
+	_, err = readEnvFile(filepath.Join(tempDir, "missing"))
+	assert.Assert(t, os.IsNotExist(err))
+}
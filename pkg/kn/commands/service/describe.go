@@ -20,6 +20,7 @@ import (
 	"io"
 	"sort"
 	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -119,7 +120,14 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return describe(cmd.OutOrStdout(), service, revisionDescs, printDetails)
+			// Domain mappings are optional, so e.g. missing permissions to list
+			// them don't prevent describing the service
+			domains, err := getMappedDomains(p, namespace, service)
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "WARNING: cannot list domain mappings, omitting domains: %v\n", err)
+			}
+
+			return describe(cmd.OutOrStdout(), service, revisionDescs, domains, printDetails)
 		},
 	}
 	flags := command.Flags()
@@ -130,11 +138,11 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 }
 
 // Main action describing the service
-func describe(w io.Writer, service *servingv1.Service, revisions []*revisionDesc, printDetails bool) error {
+func describe(w io.Writer, service *servingv1.Service, revisions []*revisionDesc, domains []string, printDetails bool) error {
 	dw := printers.NewPrefixWriter(w)
 
 	// Service info
-	writeService(dw, service)
+	writeService(dw, service, domains)
 	dw.WriteLine()
 	if err := dw.Flush(); err != nil {
 		return err
@@ -157,9 +165,10 @@ func describe(w io.Writer, service *servingv1.Service, revisions []*revisionDesc
 }
 
 // Write out main service information. Use colors for major items.
-func writeService(dw printers.PrefixWriter, service *servingv1.Service) {
+func writeService(dw printers.PrefixWriter, service *servingv1.Service, domains []string) {
 	commands.WriteMetadata(dw, &service.ObjectMeta, printDetails)
 	dw.WriteAttribute("URL", extractURL(service))
+	commands.WriteSliceDesc(dw, domains, "Domains", printDetails)
 	if printDetails {
 		if service.Status.Address != nil {
 			url := service.Status.Address.URL
@@ -174,6 +183,32 @@ func writeService(dw printers.PrefixWriter, service *servingv1.Service) {
 	}
 }
 
+// getMappedDomains returns the URLs of all custom domains mapped to the service
+func getMappedDomains(p *commands.KnParams, namespace string, service *servingv1.Service) ([]string, error) {
+	client, err := p.NewServingV1alpha1Client(namespace)
+	if err != nil {
+		return nil, err
+	}
+	domainMappingList, err := client.ListDomainMappings()
+	if err != nil {
+		return nil, err
+	}
+	var domains []string
+	for _, domainMapping := range domainMappingList.Items {
+		ref := domainMapping.Spec.Ref
+		if ref.Kind != "Service" || ref.Name != service.Name || !strings.HasPrefix(ref.APIVersion, serving.GroupName+"/") {
+			continue
+		}
+		if domainMapping.Status.URL != nil {
+			domains = append(domains, domainMapping.Status.URL.String())
+		} else {
+			domains = append(domains, domainMapping.Name)
+		}
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
 // Write out revisions associated with this service. By default only active
 // target revisions are printed, but with --verbose also inactive revisions
 // created by this services are shown
@@ -198,12 +233,14 @@ func writeRevisions(dw printers.PrefixWriter, revisions []*revisionDesc, printDe
 		revision.WriteImage(section, revisionDesc.revision)
 		if printDetails {
 			revision.WritePort(section, revisionDesc.revision)
+			revision.WriteProbes(section, revisionDesc.revision)
 			revision.WriteEnv(section, revisionDesc.revision, printDetails)
 			revision.WriteEnvFrom(section, revisionDesc.revision, printDetails)
 			revision.WriteScale(section, revisionDesc.revision)
 			revision.WriteConcurrencyOptions(section, revisionDesc.revision)
 			revision.WriteResources(section, revisionDesc.revision)
 		}
+		revision.WriteSidecars(section, revisionDesc.revision, printDetails)
 	}
 }
 
@@ -24,8 +24,12 @@ import (
 	"gotest.tools/assert"
 	"gotest.tools/assert/cmp"
 	v1 "k8s.io/api/core/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/serving/pkg/apis/autoscaling"
@@ -34,6 +38,7 @@ import (
 
 	client_serving "knative.dev/client/pkg/serving"
 	knclient "knative.dev/client/pkg/serving/v1"
+	knclientv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
 	"knative.dev/client/pkg/util"
 	"knative.dev/pkg/ptr"
 )
@@ -75,6 +80,61 @@ func TestServiceDescribeBasic(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceDescribeWithDomains(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	domainClient := knclientv1alpha1.NewMockKnServingClient(t)
+
+	r := client.Recorder()
+	expectedService := createTestService("foo", []string{"rev1"}, goodConditions())
+	r.GetService("foo", &expectedService, nil)
+	rev1 := createTestRevision("rev1", 1, goodConditions())
+	r.GetRevision("rev1", &rev1, nil)
+
+	serviceRef := func(name string) duckv1.KReference {
+		return duckv1.KReference{Kind: "Service", APIVersion: "serving.knative.dev/v1", Name: name}
+	}
+	mappedDomain := knclientv1alpha1.NewDomainMappingBuilder("foo.example.com").Reference(serviceRef("foo")).Build()
+	mappedDomain.Status.URL = &apis.URL{Scheme: "https", Host: "foo.example.com"}
+	dr := domainClient.Recorder()
+	dr.ListDomainMappings(&knclientv1alpha1.DomainMappingList{Items: []knclientv1alpha1.DomainMapping{
+		*mappedDomain,
+		*knclientv1alpha1.NewDomainMappingBuilder("www.example.com").Reference(serviceRef("foo")).Build(),
+		*knclientv1alpha1.NewDomainMappingBuilder("bar.example.com").Reference(serviceRef("bar")).Build(),
+	}}, nil)
+
+	output, err := executeServiceCommandWithDomains(client, domainClient, "describe", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Regexp("Domains:\\s+https://foo.example.com, www.example.com", output))
+	assert.Assert(t, util.ContainsNone(output, "bar.example.com"))
+
+	r.Validate()
+	dr.Validate()
+}
+
+func TestServiceDescribeWithForbiddenDomains(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	expectedService := createTestService("foo", []string{"rev1"}, goodConditions())
+	r.GetService("foo", &expectedService, nil)
+	rev1 := createTestRevision("rev1", 1, goodConditions())
+	r.GetRevision("rev1", &rev1, nil)
+
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	dynamicClient.PrependReactor("list", "domainmappings", func(a clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, api_errors.NewForbidden(knclientv1alpha1.DomainMappingGVR.GroupResource(), "", fmt.Errorf("not allowed"))
+	})
+	domainClient := knclientv1alpha1.NewKnServingClient(dynamicClient, "default")
+
+	output, err := executeServiceCommandWithDomains(client, domainClient, "describe", "foo")
+	assert.NilError(t, err)
+	validateServiceOutput(t, "foo", output)
+	assert.Assert(t, util.ContainsAll(output, "WARNING", "domain mappings"))
+	assert.Assert(t, util.ContainsNone(output, "Domains:"))
+
+	r.Validate()
+}
+
 func TestServiceDescribeWithMultipleNames(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 	r := client.Recorder()
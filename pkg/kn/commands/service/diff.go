@@ -0,0 +1,95 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientserving "knative.dev/client/pkg/serving"
+)
+
+// NewServiceDiffCommand represents 'service diff' command
+func NewServiceDiffCommand(p *commands.KnParams) *cobra.Command {
+	var diffFlags commands.DiffFlags
+	var filename string
+
+	serviceDiffCommand := &cobra.Command{
+		Use:   "diff NAME --filename FILE",
+		Short: "Show the differences between a service and a service declaration",
+		Long: `Show the differences between a service and a service declaration.
+
+The image, port, environment, mounts, resources, sidecars and autoscaling
+settings of the revision templates as well as the traffic are compared. The changes are shown
+from the service in the cluster to the declaration in the file.`,
+		Example: `
+  # Show what 'kn service apply' would change for service 'svc1'
+  kn service diff svc1 -f svc1.yaml
+
+  # Show the differences as JSON
+  kn service diff svc1 -f svc1.yaml -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service diff' requires the service name given as single argument")
+			}
+			if filename == "" {
+				return errors.New("'service diff' requires a service declaration provided with --filename")
+			}
+			err := diffFlags.Validate()
+			if err != nil {
+				return err
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			declared, err := readServiceFromFile(filename, args[0], namespace)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			service, err := client.GetService(args[0])
+			if err != nil {
+				return err
+			}
+
+			diffs := clientserving.DiffRevisionTemplates(&service.Spec.Template, &declared.Spec.Template)
+			diffs = append(diffs, clientserving.DiffTraffic(effectiveTraffic(service.Spec.Traffic), effectiveTraffic(declared.Spec.Traffic))...)
+			return diffFlags.PrintDiff(cmd.OutOrStdout(), fmt.Sprintf("service '%s'", service.Name), fmt.Sprintf("'%s'", filename), diffs)
+		},
+	}
+	commands.AddNamespaceFlags(serviceDiffCommand.Flags(), false)
+	serviceDiffCommand.Flags().StringVarP(&filename, "filename", "f", "", "Service declaration in YAML or JSON format to compare with.")
+	diffFlags.Add(serviceDiffCommand)
+	return serviceDiffCommand
+}
+
+// effectiveTraffic returns the given traffic or all traffic routed to the
+// latest revision if no traffic is specified
+func effectiveTraffic(traffic []servingv1.TrafficTarget) []servingv1.TrafficTarget {
+	if len(traffic) > 0 {
+		return traffic
+	}
+	return []servingv1.TrafficTarget{{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+}
@@ -0,0 +1,85 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceDiff(t *testing.T) {
+	declared := getService("foo")
+	declared.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v2"
+	declared.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "A", Value: "b"}}
+	declared.Spec.Traffic = []servingv1.TrafficTarget{latestTarget(50), pinnedTarget("foo-v1", 50)}
+	file := writeImportObject(t, declared)
+	defer os.RemoveAll(filepath.Dir(file))
+
+	live := getService("foo")
+	live.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v1"
+
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", live, nil)
+	output, err := executeServiceCommand(client, "diff", "foo", "-f", file)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Differences between service 'foo' and", file,
+		"~ image", "gcr.io/foo/bar:v1 -> gcr.io/foo/bar:v2",
+		"+ env[A]", "~ traffic[@latest]", "100% -> 50%", "+ traffic[foo-v1]"))
+
+	r.GetService("foo", live, nil)
+	output, err = executeServiceCommand(client, "diff", "foo", "-f", file, "-o", "json")
+	assert.NilError(t, err)
+	result := map[string]interface{}{}
+	assert.NilError(t, json.Unmarshal([]byte(output), &result))
+	assert.Equal(t, len(result["changes"].([]interface{})), 4)
+
+	// Service matches the declaration
+	r.GetService("foo", declared, nil)
+	output, err = executeServiceCommand(client, "diff", "foo", "-f", file)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No differences"))
+
+	r.Validate()
+}
+
+func TestServiceDiffErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "diff", "foo")
+	assert.ErrorContains(t, err, "--filename")
+
+	_, err = executeServiceCommand(client, "diff", "-f", "foo.yaml")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	file := writeImportObject(t, getService("foo"))
+	defer os.RemoveAll(filepath.Dir(file))
+	_, err = executeServiceCommand(client, "diff", "bar", "-f", file)
+	assert.ErrorContains(t, err, "doesn't match")
+
+	_, err = executeServiceCommand(client, "diff", "foo", "-f", file, "-o", "yaml")
+	assert.ErrorContains(t, err, "only 'json' is supported")
+
+	client.Recorder().Validate()
+}
@@ -0,0 +1,191 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
+	"knative.dev/client/pkg/kn/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+var importExample = `
+  # Import a service with its revisions exported with 'kn service export --with-revisions --mode export'
+  kn service import foo.yaml
+
+  # Import a service list exported with 'kn service export --with-revisions --mode replay' into namespace 'bar'
+  kn service import foo.json -n bar
+
+  # Import a service without waiting for the final traffic split to become ready
+  kn service import foo.yaml --no-wait`
+
+// NewServiceImportCommand returns a new command for importing a service.
+func NewServiceImportCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+
+	command := &cobra.Command{
+		Use:     "import FILE",
+		Short:   "Import a service and its revisions (experimental)",
+		Example: importExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn service import' requires the name of the file to import as single argument")
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			services, err := readServicesToImport(args[0], namespace)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			return importServices(client, services, waitFlags, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "import", "service", "ready")
+	return command
+}
+
+// readServicesToImport reads an export file and returns the services to apply in order.
+// All but the last service create a single revision, the last service carries the final
+// template and traffic split.
+func readServicesToImport(filename string, namespace string) ([]servingv1.Service, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	typeMeta := metav1.TypeMeta{}
+	err = yaml.Unmarshal(content, &typeMeta)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse import file '%s': %v", filename, err)
+	}
+
+	var services []servingv1.Service
+	switch typeMeta.Kind {
+	case "Export":
+		knExport := clientv1alpha1.Export{}
+		err = yaml.Unmarshal(content, &knExport)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse import file '%s': %v", filename, err)
+		}
+		services = servicesFromExport(&knExport)
+	case "List":
+		svcList := servingv1.ServiceList{}
+		err = yaml.Unmarshal(content, &svcList)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse import file '%s': %v", filename, err)
+		}
+		services = svcList.Items
+	case "Service":
+		svc := servingv1.Service{}
+		err = yaml.Unmarshal(content, &svc)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse import file '%s': %v", filename, err)
+		}
+		services = []servingv1.Service{svc}
+	default:
+		return nil, fmt.Errorf("cannot import file '%s' of kind '%s', only 'Export', 'List' and 'Service' created by 'kn service export' are supported", filename, typeMeta.Kind)
+	}
+
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no service found in import file '%s'", filename)
+	}
+	name := services[0].Name
+	if name == "" {
+		return nil, fmt.Errorf("import file '%s' doesn't contain a service name", filename)
+	}
+	for i := range services {
+		if services[i].Name != name {
+			return nil, fmt.Errorf("import file '%s' contains different services '%s' and '%s', only a single service can be imported", filename, name, services[i].Name)
+		}
+		services[i].Namespace = namespace
+		services[i].ResourceVersion = ""
+	}
+	return services, nil
+}
+
+// servicesFromExport converts a kn export to a sequence of services, one for each
+// exported revision (in generation order) followed by the latest service
+func servicesFromExport(knExport *clientv1alpha1.Export) []servingv1.Service {
+	latestSvc := knExport.Spec.Service
+	var services []servingv1.Service
+	for _, revision := range knExport.Spec.Revisions {
+		services = append(services, constructServiceFromRevision(&latestSvc, revision.DeepCopy()))
+	}
+	return append(services, latestSvc)
+}
+
+// importServices creates the service with the first given service and then replays all
+// other services as updates. Each intermediate step is awaited, so that every template
+// results in its own revision.
+func importServices(client clientservingv1.KnServingClient, services []servingv1.Service, waitFlags commands.WaitFlags, out io.Writer) error {
+	name := services[0].Name
+	svcExists, err := serviceExists(client, name)
+	if err != nil {
+		return err
+	}
+	if svcExists {
+		return fmt.Errorf("cannot import service '%s' in namespace '%s' because the service already exists", name, client.Namespace())
+	}
+
+	last := len(services) - 1
+	for i := range services[:last] {
+		service := services[i].DeepCopy()
+		err = createOrReplaceForImport(client, service, i == 0)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Importing revision '%s' of service '%s' in namespace '%s':\n", service.Spec.Template.Name, name, client.Namespace())
+		fmt.Fprintln(out, "")
+		err = waitForService(client, name, out, waitFlags.TimeoutInSeconds)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, "")
+	}
+
+	service := services[last].DeepCopy()
+	err = createOrReplaceForImport(client, service, last == 0)
+	if err != nil {
+		return err
+	}
+	return waitIfRequested(client, service, waitFlags, "Importing", "imported", out)
+}
+
+func createOrReplaceForImport(client clientservingv1.KnServingClient, service *servingv1.Service, create bool) error {
+	if create {
+		return client.CreateService(service)
+	}
+	return prepareAndUpdateService(client, service)
+}
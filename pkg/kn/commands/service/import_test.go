@@ -0,0 +1,140 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	apiserving "knative.dev/serving/pkg/apis/serving"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceImportNoArgs(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	_, err := executeServiceCommand(client, "import")
+	assert.ErrorContains(t, err, "requires the name of the file")
+}
+
+func TestServiceImportUnsupportedKind(t *testing.T) {
+	file := writeImportFile(t, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n"))
+	defer os.RemoveAll(filepath.Dir(file))
+
+	client := knclient.NewMockKnServiceClient(t)
+	_, err := executeServiceCommand(client, "import", file)
+	assert.ErrorContains(t, err, "ConfigMap")
+}
+
+func TestServiceImportExistingService(t *testing.T) {
+	file := writeImportObject(t, getService("foo"))
+	defer os.RemoveAll(filepath.Dir(file))
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getService("foo"), nil)
+
+	_, err := executeServiceCommand(client, "import", file)
+	assert.ErrorContains(t, err, "already exists")
+	r.Validate()
+}
+
+func TestServiceImportWithRevisions(t *testing.T) {
+	knExport := getKNExportWithOptions(
+		withKNRevisions(
+			withRevisionLabels(map[string]string{apiserving.ServiceLabelKey: "foo"}),
+			withRevisionName("foo-rev-1"),
+			withRevisionGeneration("1"),
+			withRevisionPodSpecOption(withContainer()),
+		),
+	)
+	knExport.Spec.Service = *getServiceWithOptions(
+		getService("foo"),
+		withUnwantedFieldsStripped(),
+		withServiceRevisionName("foo-rev-2"),
+		withTrafficSplit([]string{"foo-rev-1", ""}, []int{50, 50}, []bool{false, true}),
+		withServicePodSpecOption(withContainer()),
+	)
+	file := writeImportObject(t, knExport)
+	defer os.RemoveAll(filepath.Dir(file))
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(func(t *testing.T, a interface{}) {
+		svc := a.(*servingv1.Service)
+		assert.Equal(t, svc.Spec.Template.Name, "foo-rev-1")
+		assert.Equal(t, len(svc.Spec.Traffic), 0)
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getService("foo"), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		svc := a.(*servingv1.Service)
+		assert.Equal(t, svc.Spec.Template.Name, "foo-rev-2")
+		assert.Equal(t, len(svc.Spec.Traffic), 2)
+		assert.Equal(t, svc.Spec.Traffic[0].RevisionName, "foo-rev-1")
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "import", file)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo-rev-1", "Importing", "imported", "http://foo.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceImportNoWait(t *testing.T) {
+	file := writeImportObject(t, getService("foo"))
+	defer os.RemoveAll(filepath.Dir(file))
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(mock.Any(), nil)
+
+	output, err := executeServiceCommand(client, "import", file, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo", "imported", "default"))
+
+	r.Validate()
+}
+
+func writeImportObject(t *testing.T, obj interface{}) string {
+	content, err := yaml.Marshal(obj)
+	assert.NilError(t, err)
+	if svc, ok := obj.(*servingv1.Service); ok && svc.Kind == "" {
+		content = append([]byte("kind: Service\n"), content...)
+	}
+	return writeImportFile(t, content)
+}
+
+func writeImportFile(t *testing.T, content []byte) string {
+	dir, err := ioutil.TempDir("", "kn-import")
+	assert.NilError(t, err)
+	file := filepath.Join(dir, "import.yaml")
+	assert.NilError(t, ioutil.WriteFile(file, content, 0600))
+	return file
+}
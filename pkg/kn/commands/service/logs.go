@@ -0,0 +1,235 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/serving/pkg/apis/serving"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/traffic"
+)
+
+// Name of the sidecar container which Knative injects into every revision's pods
+const queueProxyContainerName = "queue-proxy"
+
+var logsExample = `
+  # Show the logs of all pods of service 'mysvc'
+  kn service logs mysvc
+
+  # Follow the logs of the latest ready revision of service 'mysvc'
+  kn service logs mysvc --revision @latest -f
+
+  # Show the logs of the last 10 minutes of the revision tagged 'candidate', including the queue-proxy
+  kn service logs mysvc --tag candidate --since 10m --include-queue-proxy`
+
+// openLogStream opens the log stream of a pod's container, can be replaced in tests
+var openLogStream = func(kubeClient kubernetes.Interface, namespace, pod string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return kubeClient.CoreV1().Pods(namespace).GetLogs(pod, options).Stream()
+}
+
+// logsOptions holds the flag values of 'kn service logs'
+type logsOptions struct {
+	revision          string
+	tag               string
+	follow            bool
+	since             time.Duration
+	includeQueueProxy bool
+}
+
+// NewServiceLogsCommand returns a new command for showing the logs of a service
+func NewServiceLogsCommand(p *commands.KnParams) *cobra.Command {
+	var options logsOptions
+
+	command := &cobra.Command{
+		Use:     "logs NAME",
+		Short:   "Show the logs of the pods of a service",
+		Example: logsExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service logs' requires the service name given as single argument")
+			}
+			serviceName := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			service, err := client.GetService(serviceName)
+			if err != nil {
+				return err
+			}
+			revision, err := traffic.ResolveRevision(service, options.revision, options.tag)
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := p.NewKubeClient()
+			if err != nil {
+				return err
+			}
+			selector := labels.Set{serving.ServiceLabelKey: serviceName}
+			if revision != "" {
+				selector[serving.RevisionLabelKey] = revision
+			}
+			pods, err := kubeClient.CoreV1().Pods(namespace).List(metav1.ListOptions{LabelSelector: selector.String()})
+			if err != nil {
+				return err
+			}
+			streams := logStreamsForPods(pods.Items, options.includeQueueProxy)
+			if len(streams) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No running pods found for service '%s'. The service might have been scaled to zero.\n", serviceName)
+				return nil
+			}
+
+			return printLogs(kubeClient, namespace, streams, options, cmd.OutOrStdout())
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&options.revision, "revision", "", "Show only the logs of the given revision. Use '@latest' for the latest ready revision.")
+	flags.StringVar(&options.tag, "tag", "", "Show only the logs of the revision with the given traffic tag.")
+	flags.BoolVarP(&options.follow, "follow", "f", false, "Stream the logs until interrupted.")
+	flags.DurationVar(&options.since, "since", 0, "Show only logs newer than the given duration, like 30s or 5m. Defaults to all logs.")
+	flags.BoolVar(&options.includeQueueProxy, "include-queue-proxy", false, "Include the logs of the queue-proxy sidecar container.")
+	return command
+}
+
+// logStream identifies the logs of a single container in a pod
+type logStream struct {
+	revision  string
+	pod       string
+	container string
+}
+
+// prefix to prepend to each log line of the stream
+func (s logStream) prefix() string {
+	if s.container == queueProxyContainerName {
+		return fmt.Sprintf("[%s:%s] ", s.revision, s.container)
+	}
+	return fmt.Sprintf("[%s] ", s.revision)
+}
+
+// logStreamsForPods returns the containers to show the logs for, sorted by
+// revision and pod. Pods which are not started yet are skipped.
+func logStreamsForPods(pods []corev1.Pod, includeQueueProxy bool) []logStream {
+	sort.Slice(pods, func(i, j int) bool {
+		ri, rj := pods[i].Labels[serving.RevisionLabelKey], pods[j].Labels[serving.RevisionLabelKey]
+		if ri != rj {
+			return ri < rj
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	var streams []logStream
+	for _, pod := range pods {
+		if pod.Status.Phase == corev1.PodPending || pod.Status.Phase == corev1.PodUnknown {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			if container.Name == queueProxyContainerName && !includeQueueProxy {
+				continue
+			}
+			streams = append(streams, logStream{
+				revision:  pod.Labels[serving.RevisionLabelKey],
+				pod:       pod.Name,
+				container: container.Name,
+			})
+		}
+	}
+	return streams
+}
+
+// printLogs prints the logs of all streams, prefixing each line. When
+// following the logs, all streams are read concurrently and their lines are
+// interleaved, otherwise the streams are printed one after the other.
+func printLogs(kubeClient kubernetes.Interface, namespace string, streams []logStream, options logsOptions, out io.Writer) error {
+	podLogOptions := &corev1.PodLogOptions{Follow: options.follow}
+	if options.since > 0 {
+		seconds := int64(options.since.Seconds())
+		podLogOptions.SinceSeconds = &seconds
+	}
+
+	if !options.follow {
+		for _, stream := range streams {
+			if err := copyLogStream(kubeClient, namespace, stream, podLogOptions, out, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs = make(chan error, len(streams))
+	)
+	for _, stream := range streams {
+		wg.Add(1)
+		go func(stream logStream) {
+			defer wg.Done()
+			errs <- copyLogStream(kubeClient, namespace, stream, podLogOptions, out, &lock)
+		}(stream)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyLogStream copies the log lines of a single container to out, using the
+// lock (if given) to serialize the writes of concurrent streams
+func copyLogStream(kubeClient kubernetes.Interface, namespace string, stream logStream, podLogOptions *corev1.PodLogOptions, out io.Writer, lock *sync.Mutex) error {
+	options := podLogOptions.DeepCopy()
+	options.Container = stream.container
+	reader, err := openLogStream(kubeClient, namespace, stream.pod, options)
+	if err != nil {
+		return fmt.Errorf("cannot get logs of container '%s' in pod '%s': %v", stream.container, stream.pod, err)
+	}
+	defer reader.Close()
+
+	prefix := stream.prefix()
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		if lock != nil {
+			lock.Lock()
+		}
+		fmt.Fprintln(out, prefix+scanner.Text())
+		if lock != nil {
+			lock.Unlock()
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,212 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceLogs(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createLogsTestService("foo"), nil)
+
+	output, err := executeServiceLogsCommand(client, []runtime.Object{
+		createLogsTestPod("foo-v2-pod", "foo-v2", corev1.PodRunning),
+		createLogsTestPod("foo-v1-pod", "foo-v1", corev1.PodRunning),
+		createLogsTestPod("foo-v3-pod", "foo-v3", corev1.PodPending),
+		createLogsTestPod("bar-v1-pod", "bar-v1", corev1.PodRunning),
+	}, "logs", "foo")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, strings.Split(strings.TrimSpace(output), "\n"), []string{
+		"[foo-v1] foo-v1-pod user-container line 1",
+		"[foo-v1] foo-v1-pod user-container line 2",
+		"[foo-v2] foo-v2-pod user-container line 1",
+		"[foo-v2] foo-v2-pod user-container line 2",
+	})
+
+	r.Validate()
+}
+
+func TestServiceLogsRevisionAndTag(t *testing.T) {
+	pods := []runtime.Object{
+		createLogsTestPod("foo-v1-pod", "foo-v1", corev1.PodRunning),
+		createLogsTestPod("foo-v2-pod", "foo-v2", corev1.PodRunning),
+	}
+	for _, args := range [][]string{
+		{"--revision", "foo-v1"},
+		{"--tag", "old"},
+		{"--revision", "@latest"},
+	} {
+		client := clientservingv1.NewMockKnServiceClient(t)
+		r := client.Recorder()
+		r.GetService("foo", createLogsTestService("foo"), nil)
+
+		output, err := executeServiceLogsCommand(client, pods, append([]string{"logs", "foo"}, args...)...)
+		assert.NilError(t, err)
+		if args[1] == "@latest" {
+			assert.Assert(t, util.ContainsAll(output, "[foo-v2] foo-v2-pod"))
+			assert.Assert(t, util.ContainsNone(output, "foo-v1"))
+		} else {
+			assert.Assert(t, util.ContainsAll(output, "[foo-v1] foo-v1-pod"))
+			assert.Assert(t, util.ContainsNone(output, "foo-v2"))
+		}
+		r.Validate()
+	}
+}
+
+func TestServiceLogsFollowWithQueueProxy(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createLogsTestService("foo"), nil)
+
+	output, err := executeServiceLogsCommand(client, []runtime.Object{
+		createLogsTestPod("foo-v1-pod", "foo-v1", corev1.PodRunning),
+		createLogsTestPod("foo-v2-pod", "foo-v2", corev1.PodRunning),
+	}, "logs", "foo", "-f", "--include-queue-proxy", "--since", "5m")
+	assert.NilError(t, err)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	assert.Equal(t, len(lines), 8)
+	assert.Assert(t, util.ContainsAll(output,
+		"[foo-v1] foo-v1-pod user-container line 1 follow=true since=300",
+		"[foo-v1:queue-proxy] foo-v1-pod queue-proxy line 2",
+		"[foo-v2:queue-proxy] foo-v2-pod queue-proxy line 1"))
+
+	r.Validate()
+}
+
+func TestServiceLogsNoPods(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createLogsTestService("foo"), nil)
+
+	output, err := executeServiceLogsCommand(client, []runtime.Object{
+		createLogsTestPod("foo-v1-pod", "foo-v1", corev1.PodPending),
+	}, "logs", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No running pods found", "'foo'", "scaled to zero"))
+
+	r.Validate()
+}
+
+func TestServiceLogsErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	_, err := executeServiceLogsCommand(client, nil, "logs")
+	assert.ErrorContains(t, err, "single argument")
+
+	r := client.Recorder()
+	r.GetService("foo", nil, fmt.Errorf("services.serving.knative.dev \"foo\" not found"))
+	_, err = executeServiceLogsCommand(client, nil, "logs", "foo")
+	assert.ErrorContains(t, err, "not found")
+
+	r.GetService("foo", createLogsTestService("foo"), nil)
+	_, err = executeServiceLogsCommand(client, nil, "logs", "foo", "--tag", "unknown")
+	assert.ErrorContains(t, err, "tag 'unknown' not found")
+
+	r.GetService("foo", createLogsTestService("foo"), nil)
+	_, err = executeServiceLogsCommand(client, []runtime.Object{
+		createLogsTestPod("foo-v1-pod", "foo-v1", corev1.PodRunning),
+	}, "logs", "foo", "--revision", "foo-v1", "--include-queue-proxy")
+	assert.ErrorContains(t, err, "cannot get logs of container 'queue-proxy' in pod 'foo-v1-pod'")
+
+	r.Validate()
+}
+
+func executeServiceLogsCommand(client clientservingv1.KnServingClient, pods []runtime.Object, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewServingClient = func(namespace string) (clientservingv1.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return kubefake.NewSimpleClientset(pods...), nil
+	}
+
+	origOpenLogStream := openLogStream
+	defer func() {
+		openLogStream = origOpenLogStream
+	}()
+	openLogStream = func(kubeClient kubernetes.Interface, namespace, pod string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+		// Only the logs of the queue-proxy of revision foo-v1 are failing when not following
+		if options.Container == queueProxyContainerName && !options.Follow {
+			return nil, fmt.Errorf("boom")
+		}
+		suffix := ""
+		if options.Follow {
+			suffix = fmt.Sprintf(" follow=true since=%d", *options.SinceSeconds)
+		}
+		logs := fmt.Sprintf("%[1]s %[2]s line 1%[3]s\n%[1]s %[2]s line 2%[3]s\n", pod, options.Container, suffix)
+		return ioutil.NopCloser(strings.NewReader(logs)), nil
+	}
+
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func createLogsTestService(name string) *servingv1.Service {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	service.Status.LatestReadyRevisionName = name + "-v2"
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{Tag: "old", RevisionName: name + "-v1", Percent: ptr.Int64(10)},
+		{LatestRevision: ptr.Bool(true), RevisionName: name + "-v2", Percent: ptr.Int64(90)},
+	}
+	return service
+}
+
+func createLogsTestPod(name, revision string, phase corev1.PodPhase) *corev1.Pod {
+	service := strings.Split(revision, "-")[0]
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				serving.ServiceLabelKey:  service,
+				serving.RevisionLabelKey: revision,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "user-container"},
+				{Name: queueProxyContainerName},
+			},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
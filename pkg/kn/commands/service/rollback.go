@@ -0,0 +1,210 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// NewServiceRollbackCommand represents 'service rollback' command
+func NewServiceRollbackCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var toRevision string
+	var steps int
+
+	serviceRollbackCommand := &cobra.Command{
+		Use:   "rollback NAME [--to REVISION|--steps N]",
+		Short: "Roll back a service to a previous revision",
+		Long: `Roll back a service to a previous revision by routing all traffic to it.
+
+Without options, the traffic is routed to the ready revision preceding the newest
+revision which currently receives traffic. Tagged traffic targets are kept without
+traffic so that their URLs stay reachable. After a rollback the traffic is pinned,
+so new revisions don't receive traffic until it is changed with 'kn service update --traffic'.`,
+		Example: `
+  # Roll back service 'svc1' to the previous ready revision
+  kn service rollback svc1
+
+  # Roll back service 'svc1' by two ready revisions
+  kn service rollback svc1 --steps 2
+
+  # Route all traffic of service 'svc1' to revision 'svc1-abcde-1'
+  kn service rollback svc1 --to svc1-abcde-1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service rollback' requires the service name given as single argument")
+			}
+			if cmd.Flags().Changed("to") && cmd.Flags().Changed("steps") {
+				return errors.New("only one of --to and --steps may be specified")
+			}
+			if steps < 1 {
+				return fmt.Errorf("invalid --steps %d, must be at least 1", steps)
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(name)
+			if err != nil {
+				return err
+			}
+			target, err := rollbackTarget(client, service, toRevision, steps)
+			if err != nil {
+				return err
+			}
+			err = checkRevisionReady(target)
+			if err != nil {
+				return fmt.Errorf("cannot roll back service '%s': %v", name, err)
+			}
+
+			out := cmd.OutOrStdout()
+			if routesAllTrafficTo(service, target.Name) {
+				fmt.Fprintf(out, "Service '%s' already routes all traffic to revision '%s'.\n", name, target.Name)
+				return nil
+			}
+
+			err = client.UpdateServiceWithRetry(name, func(service *servingv1.Service) (*servingv1.Service, error) {
+				service.Spec.Traffic = rollbackTraffic(service.Spec.Traffic, target.Name)
+				return service, nil
+			}, MaxUpdateRetries)
+			if err != nil {
+				return err
+			}
+
+			//TODO: deprecated condition should be removed once --async is gone
+			if !waitFlags.Async && waitFlags.Wait {
+				fmt.Fprintf(out, "Rolling back Service '%s' in namespace '%s' to revision '%s':\n", name, namespace, target.Name)
+				fmt.Fprintln(out, "")
+				err = waitForService(client, name, out, waitFlags.TimeoutInSeconds)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(out, "")
+			} else if waitFlags.Async {
+				fmt.Fprintf(out, "\nWARNING: flag --async is deprecated and going to be removed in future release, please use --no-wait instead.\n\n")
+			}
+			fmt.Fprintf(out, "Service '%s' rolled back to revision '%s' in namespace '%s'.\n", name, target.Name, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(serviceRollbackCommand.Flags(), false)
+	serviceRollbackCommand.Flags().StringVar(&toRevision, "to", "", "Name of the revision to roll back to.")
+	serviceRollbackCommand.Flags().IntVar(&steps, "steps", 1, "Number of ready revisions to go back from the newest revision which receives traffic.")
+	waitFlags.AddConditionWaitFlags(serviceRollbackCommand, commands.WaitDefaultTimeout, "rollback", "service", "ready")
+	return serviceRollbackCommand
+}
+
+// rollbackTarget returns the revision to roll back to, which is either the given
+// revision or the ready revision the given number of steps before the newest
+// revision receiving traffic
+func rollbackTarget(client clientservingv1.KnServingClient, service *servingv1.Service, toRevision string, steps int) (*servingv1.Revision, error) {
+	if toRevision != "" {
+		revision, err := client.GetRevision(toRevision)
+		if err != nil {
+			return nil, err
+		}
+		if revision.Labels[serving.ServiceLabelKey] != service.Name {
+			return nil, fmt.Errorf("revision '%s' doesn't belong to service '%s'", toRevision, service.Name)
+		}
+		return revision, nil
+	}
+
+	revisionList, err := client.ListRevisions(clientservingv1.WithService(service.Name))
+	if err != nil {
+		return nil, err
+	}
+	sortRevisions(revisionList)
+
+	current := currentRevisionIndex(service, revisionList)
+	if current < 0 {
+		return nil, fmt.Errorf("cannot find the revision of service '%s' which currently receives traffic", service.Name)
+	}
+	for i := current - 1; i >= 0; i-- {
+		if checkRevisionReady(&revisionList.Items[i]) != nil {
+			continue
+		}
+		steps--
+		if steps == 0 {
+			return &revisionList.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no ready revision found to roll back service '%s' from revision '%s'",
+		service.Name, revisionList.Items[current].Name)
+}
+
+// currentRevisionIndex returns the index of the newest revision in the sorted
+// revision list which receives traffic or -1 if there is no such revision
+func currentRevisionIndex(service *servingv1.Service, revisionList *servingv1.RevisionList) int {
+	routed := map[string]bool{}
+	for _, target := range service.Status.Traffic {
+		if target.Percent != nil && *target.Percent > 0 {
+			routed[target.RevisionName] = true
+		}
+	}
+	if len(routed) == 0 && service.Status.LatestReadyRevisionName != "" {
+		routed[service.Status.LatestReadyRevisionName] = true
+	}
+	for i := len(revisionList.Items) - 1; i >= 0; i-- {
+		if routed[revisionList.Items[i].Name] {
+			return i
+		}
+	}
+	return -1
+}
+
+// routesAllTrafficTo returns true if only the given revision receives traffic
+// and the traffic is pinned to it
+func routesAllTrafficTo(service *servingv1.Service, revision string) bool {
+	found := false
+	for _, target := range service.Spec.Traffic {
+		if target.Percent == nil || *target.Percent == 0 {
+			continue
+		}
+		if target.RevisionName != revision || (target.LatestRevision != nil && *target.LatestRevision) {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// rollbackTraffic returns the traffic targets which route all traffic to the
+// given revision. Tagged targets are kept with zero percent.
+func rollbackTraffic(traffic []servingv1.TrafficTarget, revision string) []servingv1.TrafficTarget {
+	var ret []servingv1.TrafficTarget
+	for _, target := range traffic {
+		if target.Tag != "" {
+			target.Percent = ptr.Int64(0)
+			ret = append(ret, target)
+		}
+	}
+	return append(ret, servingv1.TrafficTarget{RevisionName: revision, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)})
+}
@@ -0,0 +1,175 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceRollback(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := getRollbackService("foo-v3")
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		latestTarget(100),
+		{Tag: "stable", RevisionName: "foo-v2", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+	}
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(expectTraffic(
+		servingv1.TrafficTarget{Tag: "stable", RevisionName: "foo-v2", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+		pinnedTarget("foo-v1", 100),
+	), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+
+	// foo-v2 is not ready and therefore skipped
+	output, err := executeServiceCommand(client, "rollback", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Rolling back", "foo-v1", "Ready to serve", "rolled back to revision 'foo-v1'"))
+
+	r.Validate()
+}
+
+func TestServiceRollbackSteps(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.UpdateService(expectTraffic(pinnedTarget("foo-v1", 100)), nil)
+
+	output, err := executeServiceCommand(client, "rollback", "foo", "--steps", "2", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "rolled back to revision 'foo-v1'"))
+	assert.Assert(t, util.ContainsNone(output, "Rolling back"))
+
+	// The deprecated --async doesn't wait either
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.UpdateService(expectTraffic(pinnedTarget("foo-v1", 100)), nil)
+	output, err = executeServiceCommand(client, "rollback", "foo", "--steps", "2", "--async")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "WARNING: flag --async is deprecated", "rolled back to revision 'foo-v1'"))
+	assert.Assert(t, util.ContainsNone(output, "Rolling back"))
+
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	_, err = executeServiceCommand(client, "rollback", "foo", "--steps", "3")
+	assert.ErrorContains(t, err, "no ready revision found")
+	assert.ErrorContains(t, err, "foo-v4")
+
+	r.Validate()
+}
+
+func TestServiceRollbackTo(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.GetRevision("foo-v3", getRollbackRevision("foo-v3", 3, corev1.ConditionTrue), nil)
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.UpdateService(expectTraffic(pinnedTarget("foo-v3", 100)), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	output, err := executeServiceCommand(client, "rollback", "foo", "--to", "foo-v3")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "rolled back to revision 'foo-v3'"))
+
+	// Ready condition is false
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.GetRevision("foo-v2", getRollbackRevision("foo-v2", 2, corev1.ConditionFalse), nil)
+	_, err = executeServiceCommand(client, "rollback", "foo", "--to", "foo-v2")
+	assert.ErrorContains(t, err, "cannot roll back service 'foo'")
+	assert.ErrorContains(t, err, "revision 'foo-v2' is not ready")
+
+	// Revision of another service
+	other := getRollbackRevision("bar-v1", 1, corev1.ConditionTrue)
+	other.Labels[serving.ServiceLabelKey] = "bar"
+	r.GetService("foo", getRollbackService("foo-v4"), nil)
+	r.GetRevision("bar-v1", other, nil)
+	_, err = executeServiceCommand(client, "rollback", "foo", "--to", "bar-v1")
+	assert.ErrorContains(t, err, "doesn't belong to service 'foo'")
+
+	// Traffic already pinned
+	service := getRollbackService("foo-v3")
+	service.Spec.Traffic = []servingv1.TrafficTarget{pinnedTarget("foo-v3", 100)}
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-v3", getRollbackRevision("foo-v3", 3, corev1.ConditionTrue), nil)
+	output, err = executeServiceCommand(client, "rollback", "foo", "--to", "foo-v3")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "already routes all traffic to revision 'foo-v3'"))
+
+	r.Validate()
+}
+
+func TestServiceRollbackInvalidArgs(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	for _, d := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"rollback"}, "requires the service name"},
+		{[]string{"rollback", "foo", "bar"}, "requires the service name"},
+		{[]string{"rollback", "foo", "--to", "foo-v1", "--steps", "2"}, "only one of --to and --steps"},
+		{[]string{"rollback", "foo", "--steps", "0"}, "invalid --steps 0"},
+	} {
+		_, err := executeServiceCommand(client, d.args...)
+		assert.ErrorContains(t, err, d.expected)
+	}
+	client.Recorder().Validate()
+}
+
+func getRollbackService(currentRevision string) *servingv1.Service {
+	service := getService("foo")
+	service.Spec.Traffic = []servingv1.TrafficTarget{latestTarget(100)}
+	service.Status.LatestReadyRevisionName = currentRevision
+	service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: currentRevision, Percent: ptr.Int64(100)}}
+	return service
+}
+
+// getRollbackRevisionList returns the revisions foo-v1 to foo-v4 in random
+// order, with foo-v2 not being ready
+func getRollbackRevisionList() *servingv1.RevisionList {
+	return &servingv1.RevisionList{Items: []servingv1.Revision{
+		*getRollbackRevision("foo-v3", 3, corev1.ConditionTrue),
+		*getRollbackRevision("foo-v1", 1, corev1.ConditionTrue),
+		*getRollbackRevision("foo-v4", 4, corev1.ConditionTrue),
+		*getRollbackRevision("foo-v2", 2, corev1.ConditionFalse),
+	}}
+}
+
+func getRollbackRevision(name string, generation int, ready corev1.ConditionStatus) *servingv1.Revision {
+	revision := getRolloutRevision(name, ready)
+	revision.Labels = map[string]string{
+		serving.ServiceLabelKey:                 "foo",
+		serving.ConfigurationGenerationLabelKey: strconv.Itoa(generation),
+	}
+	return revision
+}
@@ -0,0 +1,215 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// rolloutSleep waits between two rollout steps, can be replaced in tests
+var rolloutSleep = time.Sleep
+
+// rolloutFlags holds the flags for progressively shifting traffic to a new revision
+type rolloutFlags struct {
+	steps        string
+	stepInterval time.Duration
+}
+
+// Add adds the rollout flags to the given command
+func (r *rolloutFlags) Add(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&r.steps, "rollout", "",
+		"Shift traffic progressively from the current latest ready revision to the new revision. "+
+			"Takes a comma separated list of increasing traffic percentages for the new revision, e.g. '10,25,50,100'. "+
+			"If the new revision becomes not ready during the rollout, all traffic is routed back to the previous revision. "+
+			"A rollout ending at 100 routes all traffic to the latest ready revision again, otherwise the traffic stays pinned "+
+			"to the two revisions.")
+	cmd.Flags().DurationVar(&r.stepInterval, "step-interval", time.Minute,
+		"Time to wait between two steps of a --rollout.")
+}
+
+// Changed returns true if a rollout has been requested
+func (r *rolloutFlags) Changed(cmd *cobra.Command) bool {
+	return cmd.Flags().Changed("rollout")
+}
+
+// Parse returns the traffic percentages of the rollout steps
+func (r *rolloutFlags) Parse() ([]int64, error) {
+	var steps []int64
+	for _, s := range strings.Split(r.steps, ",") {
+		percent, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimSpace(s), "%"), 10, 64)
+		if err != nil || percent <= 0 || percent > 100 {
+			return nil, fmt.Errorf("invalid --rollout step '%s', expected a percentage between 1 and 100", s)
+		}
+		if len(steps) > 0 && percent <= steps[len(steps)-1] {
+			return nil, fmt.Errorf("invalid --rollout '%s', the percentages must be increasing", r.steps)
+		}
+		steps = append(steps, percent)
+	}
+	if r.stepInterval < 0 {
+		return nil, fmt.Errorf("invalid --step-interval %s, must not be negative", r.stepInterval)
+	}
+	return steps, nil
+}
+
+// rollout holds the state of a progressive traffic shift to a new revision
+type rollout struct {
+	client          clientservingv1.KnServingClient
+	serviceName     string
+	steps           []int64
+	stepInterval    time.Duration
+	timeout         int
+	originalTraffic []servingv1.TrafficTarget
+	oldRevision     string
+	newRevision     string
+}
+
+// prepare pins the traffic of the service to its current latest ready
+// revision, so that the revision created by the update doesn't receive any
+// traffic yet
+func (r *rollout) prepare(service *servingv1.Service) error {
+	r.oldRevision = service.Status.LatestReadyRevisionName
+	if r.oldRevision == "" {
+		return fmt.Errorf("cannot roll out service '%s' because it has no ready revision to roll out from", service.Name)
+	}
+	r.originalTraffic = service.Spec.DeepCopy().Traffic
+	service.Spec.Traffic = r.trafficFor(0)
+	return nil
+}
+
+// run walks through the rollout steps after the new revision has been created
+func (r *rollout) run(out io.Writer) error {
+	fmt.Fprintln(out, "Waiting for the new revision to become ready:")
+	fmt.Fprintln(out, "")
+	if err := waitForService(r.client, r.serviceName, out, r.timeout); err != nil {
+		return r.rollback(out, err)
+	}
+	service, err := r.client.GetService(r.serviceName)
+	if err != nil {
+		return err
+	}
+	if service.Status.LatestReadyRevisionName == r.oldRevision {
+		if err := r.updateTraffic(r.originalTraffic); err != nil {
+			return err
+		}
+		return fmt.Errorf("no new revision has been created for service '%s', nothing to roll out", r.serviceName)
+	}
+	r.newRevision = service.Status.LatestReadyRevisionName
+	fmt.Fprintln(out, "")
+
+	for i, percent := range r.steps {
+		if i > 0 {
+			rolloutSleep(r.stepInterval)
+		}
+		if err := r.checkNewRevisionReady(); err != nil {
+			return r.rollback(out, err)
+		}
+		if err := r.updateTraffic(r.trafficFor(percent)); err != nil {
+			return r.rollback(out, err)
+		}
+		if err := waitForService(r.client, r.serviceName, out, r.timeout); err != nil {
+			return r.rollback(out, err)
+		}
+		fmt.Fprintf(out, "Step %d/%d: %d%% of traffic routed to revision '%s'.\n", i+1, len(r.steps), percent, r.newRevision)
+	}
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "Service '%s' rolled out to revision '%s'.\n", r.serviceName, r.newRevision)
+	return nil
+}
+
+// rollback routes the traffic back to the old revision and returns the error
+// which caused the rollback
+func (r *rollout) rollback(out io.Writer, cause error) error {
+	traffic := make([]servingv1.TrafficTarget, 0, len(r.originalTraffic))
+	for _, target := range r.originalTraffic {
+		if target.LatestRevision != nil && *target.LatestRevision {
+			target.LatestRevision = ptr.Bool(false)
+			target.RevisionName = r.oldRevision
+		}
+		traffic = append(traffic, target)
+	}
+	if len(traffic) == 0 {
+		traffic = append(traffic, servingv1.TrafficTarget{RevisionName: r.oldRevision, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)})
+	}
+	if err := r.updateTraffic(traffic); err != nil {
+		return fmt.Errorf("rollout of service '%s' failed: %v, and rolling back to revision '%s' failed, too: %v", r.serviceName, cause, r.oldRevision, err)
+	}
+	fmt.Fprintf(out, "Rolled back all traffic to revision '%s'.\n", r.oldRevision)
+	return fmt.Errorf("rollout of service '%s' failed: %v", r.serviceName, cause)
+}
+
+// trafficFor returns the traffic targets which route the given percentage to
+// the new revision and the rest to the old revision. Tagged targets of the
+// original traffic are kept with zero percent so that their URLs stay
+// reachable. Without a new revision or once the rollout reaches 100 percent,
+// the latest revision is used instead, so that the service follows its latest
+// ready revision again after a completed rollout.
+func (r *rollout) trafficFor(percent int64) []servingv1.TrafficTarget {
+	var traffic []servingv1.TrafficTarget
+	for _, target := range r.originalTraffic {
+		if target.Tag != "" {
+			target.Percent = ptr.Int64(0)
+			traffic = append(traffic, target)
+		}
+	}
+	if percent < 100 {
+		traffic = append(traffic, servingv1.TrafficTarget{RevisionName: r.oldRevision, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100 - percent)})
+	}
+	if r.newRevision == "" || percent == 100 {
+		traffic = append(traffic, servingv1.TrafficTarget{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(percent)})
+	} else {
+		traffic = append(traffic, servingv1.TrafficTarget{RevisionName: r.newRevision, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(percent)})
+	}
+	return traffic
+}
+
+func (r *rollout) updateTraffic(traffic []servingv1.TrafficTarget) error {
+	return r.client.UpdateServiceWithRetry(r.serviceName, func(service *servingv1.Service) (*servingv1.Service, error) {
+		service.Spec.Traffic = traffic
+		return service, nil
+	}, MaxUpdateRetries)
+}
+
+func (r *rollout) checkNewRevisionReady() error {
+	revision, err := r.client.GetRevision(r.newRevision)
+	if err != nil {
+		return err
+	}
+	return checkRevisionReady(revision)
+}
+
+// checkRevisionReady returns an error if the Ready condition of the revision is not true
+func checkRevisionReady(revision *servingv1.Revision) error {
+	ready := revision.Status.GetCondition(apis.ConditionReady)
+	if ready == nil || ready.Status != corev1.ConditionTrue {
+		reason := "unknown"
+		if ready != nil && ready.Reason != "" {
+			reason = ready.Reason
+		}
+		return fmt.Errorf("revision '%s' is not ready (reason: %s)", revision.Name, reason)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceUpdateRollout(t *testing.T) {
+	defer stubRolloutSleep(t, 2*time.Minute)()
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", getRolloutService("foo-v1"), nil)
+	r.UpdateService(expectTraffic(pinnedTarget("foo-v1", 100), latestTarget(0)), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getRolloutService("foo-v2"), nil)
+	for _, traffic := range [][]servingv1.TrafficTarget{
+		{pinnedTarget("foo-v1", 75), pinnedTarget("foo-v2", 25)},
+		{latestTarget(100)},
+	} {
+		r.GetRevision("foo-v2", getRolloutRevision("foo-v2", corev1.ConditionTrue), nil)
+		r.GetService("foo", getRolloutService("foo-v2"), nil)
+		r.UpdateService(expectTraffic(traffic...), nil)
+		r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	}
+
+	output, err := executeServiceCommand(client, "update", "foo", "--env", "a=b", "--rollout", "25,100", "--step-interval", "2m")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Rolling out", "Step 1/2: 25%", "Step 2/2: 100%", "rolled out to revision 'foo-v2'"))
+
+	r.Validate()
+}
+
+func TestServiceUpdateRolloutRollback(t *testing.T) {
+	defer stubRolloutSleep(t, time.Minute)()
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", getRolloutService("foo-v1"), nil)
+	r.UpdateService(mock.Any(), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getRolloutService("foo-v2"), nil)
+	r.GetRevision("foo-v2", getRolloutRevision("foo-v2", corev1.ConditionTrue), nil)
+	r.GetService("foo", getRolloutService("foo-v2"), nil)
+	r.UpdateService(mock.Any(), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetRevision("foo-v2", getRolloutRevision("foo-v2", corev1.ConditionFalse), nil)
+	r.GetService("foo", getRolloutService("foo-v2"), nil)
+	r.UpdateService(expectTraffic(pinnedTarget("foo-v1", 100)), nil)
+
+	output, err := executeServiceCommand(client, "update", "foo", "--env", "a=b", "--rollout", "50,100")
+	assert.ErrorContains(t, err, "revision 'foo-v2' is not ready")
+	assert.ErrorContains(t, err, "ContainerMissing")
+	assert.Assert(t, util.ContainsAll(output, "Step 1/2: 50%", "Rolled back all traffic to revision 'foo-v1'"))
+
+	r.Validate()
+}
+
+func TestServiceUpdateRolloutNewRevisionNotReady(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", getRolloutService("foo-v1"), nil)
+	r.UpdateService(mock.Any(), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), errors.New("revision failed"), time.Second)
+	r.GetService("foo", getRolloutService("foo-v1"), nil)
+	r.UpdateService(expectTraffic(pinnedTarget("foo-v1", 100)), nil)
+
+	_, err := executeServiceCommand(client, "update", "foo", "--env", "a=b", "--rollout", "100")
+	assert.ErrorContains(t, err, "revision failed")
+
+	r.Validate()
+}
+
+func TestServiceUpdateRolloutNoReadyRevision(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", getRolloutService(""), nil)
+
+	_, err := executeServiceCommand(client, "update", "foo", "--env", "a=b", "--rollout", "100")
+	assert.ErrorContains(t, err, "no ready revision")
+
+	r.Validate()
+}
+
+func TestServiceUpdateRolloutInvalidFlags(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	for _, args := range [][]string{
+		{"--rollout", "10,abc"},
+		{"--rollout", "0,100"},
+		{"--rollout", "50,25"},
+		{"--rollout", "10,100", "--step-interval", "-1m"},
+		{"--rollout", "100", "--traffic", "@latest=100"},
+		{"--rollout", "100", "--no-wait"},
+	} {
+		_, err := executeServiceCommand(client, append([]string{"update", "foo"}, args...)...)
+		assert.ErrorContains(t, err, "--", "args: %v", args)
+	}
+	client.Recorder().Validate()
+}
+
+func TestRolloutFlagsParse(t *testing.T) {
+	flags := rolloutFlags{steps: "10, 25%,100"}
+	steps, err := flags.Parse()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, steps, []int64{10, 25, 100})
+}
+
+func stubRolloutSleep(t *testing.T, expected time.Duration) func() {
+	origSleep := rolloutSleep
+	rolloutSleep = func(d time.Duration) {
+		assert.Equal(t, d, expected)
+	}
+	return func() {
+		rolloutSleep = origSleep
+	}
+}
+
+func expectTraffic(traffic ...servingv1.TrafficTarget) func(t *testing.T, a interface{}) {
+	return func(t *testing.T, a interface{}) {
+		service := a.(*servingv1.Service)
+		assert.DeepEqual(t, service.Spec.Traffic, traffic)
+	}
+}
+
+func pinnedTarget(revision string, percent int64) servingv1.TrafficTarget {
+	return servingv1.TrafficTarget{RevisionName: revision, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(percent)}
+}
+
+func latestTarget(percent int64) servingv1.TrafficTarget {
+	return servingv1.TrafficTarget{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(percent)}
+}
+
+func getRolloutService(latestReadyRevision string) *servingv1.Service {
+	service := getService("foo")
+	service.Spec.Traffic = []servingv1.TrafficTarget{latestTarget(100)}
+	service.Status.LatestReadyRevisionName = latestReadyRevision
+	return service
+}
+
+func getRolloutRevision(name string, ready corev1.ConditionStatus) *servingv1.Revision {
+	revision := &servingv1.Revision{}
+	revision.Name = name
+	revision.Status.Conditions = duckv1.Conditions{
+		{Type: apis.ConditionReady, Status: ready, Reason: "ContainerMissing"},
+	}
+	return revision
+}
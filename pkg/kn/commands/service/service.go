@@ -41,7 +41,12 @@ func NewServiceCommand(p *commands.KnParams) *cobra.Command {
 	serviceCmd.AddCommand(NewServiceCreateCommand(p))
 	serviceCmd.AddCommand(NewServiceDeleteCommand(p))
 	serviceCmd.AddCommand(NewServiceUpdateCommand(p))
+	serviceCmd.AddCommand(NewServiceApplyCommand(p))
 	serviceCmd.AddCommand(NewServiceExportCommand(p))
+	serviceCmd.AddCommand(NewServiceImportCommand(p))
+	serviceCmd.AddCommand(NewServiceLogsCommand(p))
+	serviceCmd.AddCommand(NewServiceRollbackCommand(p))
+	serviceCmd.AddCommand(NewServiceDiffCommand(p))
 	return serviceCmd
 }
 
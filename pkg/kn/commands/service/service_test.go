@@ -18,11 +18,14 @@ import (
 	"bytes"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"knative.dev/client/pkg/kn/commands"
 	knflags "knative.dev/client/pkg/kn/flags"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	clientservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
 )
 
 // Helper methods
@@ -51,6 +54,11 @@ current-context: x
 }
 
 func executeServiceCommand(client clientservingv1.KnServingClient, args ...string) (string, error) {
+	noDomainsClient := clientservingv1alpha1.NewKnServingClient(dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), "default")
+	return executeServiceCommandWithDomains(client, noDomainsClient, args...)
+}
+
+func executeServiceCommandWithDomains(client clientservingv1.KnServingClient, domainClient clientservingv1alpha1.KnServingClient, args ...string) (string, error) {
 	knParams := &commands.KnParams{}
 	knParams.ClientConfig = blankConfig
 
@@ -59,6 +67,9 @@ func executeServiceCommand(client clientservingv1.KnServingClient, args ...strin
 	knParams.NewServingClient = func(namespace string) (clientservingv1.KnServingClient, error) {
 		return client, nil
 	}
+	knParams.NewServingV1alpha1Client = func(namespace string) (clientservingv1alpha1.KnServingClient, error) {
+		return domainClient, nil
+	}
 	cmd := NewServiceCommand(knParams)
 	cmd.SetArgs(args)
 	cmd.SetOutput(output)
@@ -15,11 +15,15 @@
 package service
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"gotest.tools/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -1473,3 +1477,225 @@ func TestServiceUpdateUser(t *testing.T) {
 
 	r.Validate()
 }
+
+func TestServiceUpdateSidecar(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	svcName := "svc1"
+	newService := getService(svcName)
+	template := &newService.Spec.Template
+	template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+	template.Spec.Containers = append(template.Spec.Containers, corev1.Container{Name: "logger", Image: "fluent/bit"})
+	template.ObjectMeta.Annotations = map[string]string{
+		clientserving.UserImageAnnotationKey: "gcr.io/foo/bar:baz",
+	}
+
+	updatedService := getService(svcName)
+	template = &updatedService.Spec.Template
+	template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+	template.Spec.Containers = append(template.Spec.Containers,
+		corev1.Container{
+			Name:  "logger",
+			Image: "fluent/bit:v2",
+			Env:   []corev1.EnvVar{{Name: "LEVEL", Value: "debug"}},
+			Resources: corev1.ResourceRequirements{
+				Limits:   corev1.ResourceList{},
+				Requests: corev1.ResourceList{},
+			},
+		},
+		corev1.Container{Name: "proxy", Image: "envoy"})
+	template.ObjectMeta.Annotations = map[string]string{
+		clientserving.UserImageAnnotationKey: "gcr.io/foo/bar:baz",
+	}
+
+	r := client.Recorder()
+	recordServiceUpdateWithSuccess(r, svcName, newService, updatedService)
+
+	output, err := executeServiceCommand(client,
+		"create", svcName, "--image", "gcr.io/foo/bar:baz",
+		"--sidecar", "logger=fluent/bit",
+		"--no-wait", "--revision-name=",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "created", svcName, "default"))
+
+	output, err = executeServiceCommand(client,
+		"update", svcName,
+		"--container", "logger", "--image", "fluent/bit:v2", "--env", "LEVEL=debug",
+		"--sidecar", "proxy=envoy",
+		"--no-wait", "--revision-name=",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "updated", svcName, "default"))
+
+	r.Validate()
+}
+
+func TestServiceUpdateUnknownContainer(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	svcName := "svc1"
+	service := getService(svcName)
+	service.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+
+	r := client.Recorder()
+	r.GetService(svcName, service, nil)
+
+	_, err := executeServiceCommand(client,
+		"update", svcName, "--container", "logger", "--image", "fluent/bit:v2", "--no-wait")
+	assert.ErrorContains(t, err, "no container 'logger' found")
+
+	r.Validate()
+}
+
+func TestServiceUpdatePortOfSidecar(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	svcName := "svc1"
+	service := getService(svcName)
+	service.Spec.Template.Spec.Containers = append(service.Spec.Template.Spec.Containers,
+		corev1.Container{Name: "logger", Image: "fluent/bit"})
+
+	r := client.Recorder()
+	r.GetService(svcName, service, nil)
+
+	_, err := executeServiceCommand(client,
+		"update", svcName, "--container", "logger", "--port", "8080", "--no-wait")
+	assert.ErrorContains(t, err, "--port can't be set for sidecar container \"logger\"")
+
+	r.Validate()
+}
+
+func TestServiceUpdateProbes(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	svcName := "svc1"
+	newService := getService(svcName)
+	template := &newService.Spec.Template
+	template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+	template.Spec.Containers[0].ReadinessProbe = &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)},
+		},
+		InitialDelaySeconds: 5,
+	}
+	template.Spec.Containers[0].LivenessProbe = &corev1.Probe{
+		Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(8080)}},
+	}
+	template.ObjectMeta.Annotations = map[string]string{
+		clientserving.UserImageAnnotationKey: "gcr.io/foo/bar:baz",
+	}
+
+	updatedService := getService(svcName)
+	template = &updatedService.Spec.Template
+	template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+	template.Spec.Containers[0].ReadinessProbe = &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)},
+		},
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       10,
+	}
+	template.ObjectMeta.Annotations = map[string]string{
+		clientserving.UserImageAnnotationKey: "gcr.io/foo/bar:baz",
+	}
+
+	r := client.Recorder()
+	recordServiceUpdateWithSuccess(r, svcName, newService, updatedService)
+
+	output, err := executeServiceCommand(client,
+		"create", svcName, "--image", "gcr.io/foo/bar:baz",
+		"--probe-readiness", "http:/healthz:8080", "--probe-readiness-opts", "InitialDelaySeconds=5",
+		"--probe-liveness", "tcp:8080",
+		"--no-wait", "--revision-name=",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "created", svcName, "default"))
+
+	output, err = executeServiceCommand(client,
+		"update", svcName,
+		"--probe-readiness-opts", "PeriodSeconds=10",
+		"--probe-liveness", "-",
+		"--no-wait", "--revision-name=",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "updated", svcName, "default"))
+
+	r.Validate()
+}
+
+func TestServiceUpdateEnvValueFromAndEnvFile(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "kn-env-file")
+	assert.NilError(t, err)
+	defer os.RemoveAll(tempDir)
+	envFile := filepath.Join(tempDir, ".env")
+	assert.NilError(t, ioutil.WriteFile(envFile, []byte("# settings\nDB_HOST=db\nDB_PORT=5432\n"), 0600))
+
+	client := clientservingv1.NewMockKnServiceClient(t)
+	svcName := "svc1"
+	newService := getService(svcName)
+	template := &newService.Spec.Template
+	template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+	template.Spec.Containers[0].Env = []corev1.EnvVar{
+		{Name: "DB_HOST", Value: "db"},
+		{Name: "DB_PASS", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "db"},
+			Key:                  "password",
+		}}},
+		{Name: "DB_PORT", Value: "6543"},
+	}
+	template.ObjectMeta.Annotations = map[string]string{
+		clientserving.UserImageAnnotationKey: "gcr.io/foo/bar:baz",
+	}
+
+	updatedService := getService(svcName)
+	template = &updatedService.Spec.Template
+	template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+	template.Spec.Containers[0].Env = []corev1.EnvVar{
+		{Name: "DB_HOST", Value: "db"},
+		{Name: "DB_PORT", Value: "6543"},
+		{Name: "NAMESPACE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{
+			FieldPath: "metadata.namespace",
+		}}},
+	}
+	template.ObjectMeta.Annotations = map[string]string{
+		clientserving.UserImageAnnotationKey: "gcr.io/foo/bar:baz",
+	}
+
+	r := client.Recorder()
+	recordServiceUpdateWithSuccess(r, svcName, newService, updatedService)
+
+	output, err := executeServiceCommand(client,
+		"create", svcName, "--image", "gcr.io/foo/bar:baz",
+		"--env-file", envFile, "--env", "DB_PORT=6543",
+		"--env-value-from", "DB_PASS=secret:db:password",
+		"--no-wait", "--revision-name=",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "created", svcName, "default"))
+
+	output, err = executeServiceCommand(client,
+		"update", svcName,
+		"--env-value-from", "NAMESPACE=fieldRef:metadata.namespace", "--env-value-from", "DB_PASS-",
+		"--no-wait", "--revision-name=",
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "updated", svcName, "default"))
+
+	r.Validate()
+
+	_, err = executeServiceCommand(client,
+		"create", svcName, "--image", "gcr.io/foo/bar:baz",
+		"--env", "DB_PASS=secret", "--env-value-from", "DB_PASS=secret:db:password",
+	)
+	assert.ErrorContains(t, err, "can't be set with both --env and --env-value-from")
+
+	_, err = executeServiceCommand(client,
+		"create", svcName, "--image", "gcr.io/foo/bar:baz",
+		"--env", "FOO=bar", "--env-value-from", "FOO-",
+	)
+	assert.ErrorContains(t, err, "environment variable \"FOO\" can't be set with both --env and --env-value-from")
+
+	// Variables of an env file conflict in the same way
+	_, err = executeServiceCommand(client,
+		"create", svcName, "--image", "gcr.io/foo/bar:baz",
+		"--env-file", envFile, "--env-value-from", "DB_HOST=cm:db:host",
+	)
+	assert.ErrorContains(t, err, "environment variable \"DB_HOST\" can't be set with both --env-file and --env-value-from")
+}
@@ -48,12 +48,17 @@ var updateExample = `
   kn service update svc --untag testing --tag @latest=staging
 
   # Add tag 'test' to echo-v3 revision with 10% traffic and rest to latest ready revision of service
-  kn service update svc --tag echo-v3=test --traffic test=10,@latest=90`
+  kn service update svc --tag echo-v3=test --traffic test=10,@latest=90
+
+  # Update the image of service 'svc' and shift traffic to the new revision in steps of 10%, 25%, 50% and 100%,
+  # waiting 2 minutes between the steps and rolling back if the new revision becomes not ready
+  kn service update svc --image myimage:v2 --rollout 10,25,50,100 --step-interval 2m`
 
 func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	var editFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
 	var trafficFlags flags.Traffic
+	var rolloutFlags rolloutFlags
 	serviceUpdateCommand := &cobra.Command{
 		Use:     "update NAME",
 		Short:   "Update a service",
@@ -77,6 +82,27 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 			var latestRevisionBeforeUpdate string
 			name := args[0]
 
+			var ro *rollout
+			if rolloutFlags.Changed(cmd) {
+				if trafficFlags.Changed(cmd) {
+					return errors.New("--rollout can't be combined with --traffic, --tag or --untag")
+				}
+				if waitFlags.Async || !waitFlags.Wait {
+					return errors.New("--rollout can't be combined with --no-wait or --async")
+				}
+				steps, err := rolloutFlags.Parse()
+				if err != nil {
+					return err
+				}
+				ro = &rollout{
+					client:       client,
+					serviceName:  name,
+					steps:        steps,
+					stepInterval: rolloutFlags.stepInterval,
+					timeout:      waitFlags.TimeoutInSeconds,
+				}
+			}
+
 			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
 				latestRevisionBeforeUpdate = service.Status.LatestReadyRevisionName
 				var baseRevision *servingv1.Revision
@@ -99,6 +125,13 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 
 					service.Spec.Traffic = traffic
 				}
+
+				if ro != nil {
+					err = ro.prepare(service)
+					if err != nil {
+						return nil, err
+					}
+				}
 				return service, nil
 			}
 
@@ -109,6 +142,12 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			out := cmd.OutOrStdout()
+			if ro != nil {
+				fmt.Fprintf(out, "Rolling out Service '%s' in namespace '%s':\n", name, namespace)
+				fmt.Fprintln(out, "")
+				return ro.run(out)
+			}
+
 			//TODO: deprecated condition should be once --async is gone
 			if !waitFlags.Async && waitFlags.Wait {
 				fmt.Fprintf(out, "Updating Service '%s' in namespace '%s':\n", args[0], namespace)
@@ -138,6 +177,7 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	editFlags.AddUpdateFlags(serviceUpdateCommand)
 	waitFlags.AddConditionWaitFlags(serviceUpdateCommand, commands.WaitDefaultTimeout, "update", "service", "ready")
 	trafficFlags.Add(serviceUpdateCommand)
+	rolloutFlags.Add(serviceUpdateCommand)
 	return serviceUpdateCommand
 }
 
@@ -0,0 +1,67 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	v1alpha2 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+)
+
+// NewContainerCommand is the root command for all Container source related commands
+func NewContainerCommand(p *commands.KnParams) *cobra.Command {
+	containerCmd := &cobra.Command{
+		Use:   "container COMMAND",
+		Short: "Manage container sources",
+	}
+	containerCmd.AddCommand(NewContainerCreateCommand(p))
+	containerCmd.AddCommand(NewContainerDeleteCommand(p))
+	containerCmd.AddCommand(NewContainerDescribeCommand(p))
+	containerCmd.AddCommand(NewContainerUpdateCommand(p))
+	containerCmd.AddCommand(NewContainerListCommand(p))
+	return containerCmd
+}
+
+var containerSourceClientFactory func(config clientcmd.ClientConfig, namespace string) (clientv1alpha2.KnContainerSourcesClient, error)
+
+func newContainerSourceClient(p *commands.KnParams, cmd *cobra.Command) (clientv1alpha2.KnContainerSourcesClient, error) {
+	namespace, err := p.GetNamespace(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if containerSourceClientFactory != nil {
+		config, err := p.GetClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		return containerSourceClientFactory(config, namespace)
+	}
+
+	clientConfig, err := p.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := v1alpha2.NewForConfig(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientv1alpha2.NewKnSourcesClient(client, namespace).ContainerSourcesClient(), nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/kn/commands/service"
+	"knative.dev/client/pkg/sources/v1alpha2"
+)
+
+// NewContainerCreateCommand for creating source
+func NewContainerCreateCommand(p *commands.KnParams) *cobra.Command {
+	var containerFlags service.ConfigurationEditFlags
+	var sinkFlags flags.SinkFlags
+
+	cmd := &cobra.Command{
+		Use:   "create NAME --image IMAGE --sink SINK",
+		Short: "Create a container source",
+		Example: `
+  # Create a ContainerSource 'src' to start a container with image 'docker.io/sample/image' and send messages to service 'mysvc'
+  kn source container create src --image docker.io/sample/image --sink svc:mysvc
+
+  # Create a ContainerSource which passes a configuration via environment variable and a command line argument
+  kn source container create src --image docker.io/sample/image --env PERIOD=10 --arg --verbose --sink svc:mysvc`,
+
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("requires the name of the Container source to create as single argument")
+			}
+			name := args[0]
+			if containerFlags.Image == "" {
+				return errors.New("'source container create' requires the image name to run provided with the --image option")
+			}
+
+			containerSourceClient, err := newContainerSourceClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			destination, err := sinkFlags.ResolveSink(dynamicClient, namespace)
+			if err != nil {
+				return err
+			}
+
+			podTemplate := &corev1.PodTemplateSpec{}
+			err = containerFlags.ApplyToPodTemplate(podTemplate, cmd)
+			if err != nil {
+				return err
+			}
+
+			err = containerSourceClient.CreateContainerSource(
+				v1alpha2.NewContainerSourceBuilder(name).
+					PodSpec(podTemplate.Spec).
+					Sink(*destination).
+					Build())
+			if err == nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Container source '%s' created in namespace '%s'.\n", args[0], containerSourceClient.Namespace())
+			}
+			return err
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	containerFlags.AddContainerFlags(cmd)
+	sinkFlags.Add(cmd)
+	cmd.MarkFlagRequired("sink")
+
+	return cmd
+}
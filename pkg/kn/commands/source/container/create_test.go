@@ -0,0 +1,94 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sourcesv1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	"knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
+)
+
+func TestCreateContainerSource(t *testing.T) {
+	mysvc := &servingv1.Service{
+		TypeMeta:   v1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: v1.ObjectMeta{Name: "mysvc", Namespace: "default"},
+	}
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", mysvc)
+
+	containerClient := v1alpha2.NewMockKnContainerSourceClient(t)
+	recorder := containerClient.Recorder()
+	env := []corev1.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}}
+	recorder.CreateContainerSource(createContainerSource("testsource", "docker.io/test/img", "mysvc", env, []string{"--verbose"}), nil)
+
+	out, err := executeContainerSourceCommand(containerClient, dynamicClient, "create", "testsource",
+		"--image", "docker.io/test/img", "--env", "B=2", "--env", "A=1", "--arg", "--verbose", "--sink", "svc:mysvc")
+	assert.NilError(t, err, "Source should have been created")
+	assert.Assert(t, util.ContainsAll(out, "created", "default", "testsource"))
+
+	recorder.Validate()
+}
+
+func TestCreateContainerSourceWithMount(t *testing.T) {
+	mysvc := &servingv1.Service{
+		TypeMeta:   v1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: v1.ObjectMeta{Name: "mysvc", Namespace: "default"},
+	}
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", mysvc)
+
+	containerClient := v1alpha2.NewMockKnContainerSourceClient(t)
+	recorder := containerClient.Recorder()
+	recorder.CreateContainerSource(func(t *testing.T, a interface{}) {
+		podSpec := a.(*sourcesv1alpha2.ContainerSource).Spec.Template.Spec
+		assert.Equal(t, len(podSpec.Volumes), 1)
+		assert.Equal(t, podSpec.Volumes[0].ConfigMap.Name, "mycm")
+		container := podSpec.Containers[0]
+		assert.Equal(t, container.VolumeMounts[0].MountPath, "/config")
+		assert.Equal(t, container.EnvFrom[0].SecretRef.Name, "mysecret")
+		assert.DeepEqual(t, container.Command, []string{"/app/start"})
+	}, nil)
+
+	_, err := executeContainerSourceCommand(containerClient, dynamicClient, "create", "testsource",
+		"--image", "docker.io/test/img", "--mount", "/config=cm:mycm", "--env-from", "secret:mysecret",
+		"--cmd", "/app/start", "--sink", "svc:mysvc")
+	assert.NilError(t, err)
+
+	recorder.Validate()
+}
+
+func TestCreateContainerSourceErrors(t *testing.T) {
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+	containerClient := v1alpha2.NewMockKnContainerSourceClient(t)
+
+	_, err := executeContainerSourceCommand(containerClient, dynamicClient, "create", "--image", "docker.io/test/img", "--sink", "svc:mysvc")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeContainerSourceCommand(containerClient, dynamicClient, "create", "testsource", "--sink", "svc:mysvc")
+	assert.ErrorContains(t, err, "--image")
+
+	out, err := executeContainerSourceCommand(containerClient, dynamicClient, "create", "testsource", "--image", "docker.io/test/img")
+	assert.ErrorContains(t, err, "sink")
+	assert.Assert(t, util.ContainsAll(out, "Usage", "required"))
+
+	_, err = executeContainerSourceCommand(containerClient, dynamicClient, "create", "testsource", "--image", "docker.io/test/img", "--sink", "svc:mysvc")
+	assert.ErrorContains(t, err, "\"mysvc\" not found")
+}
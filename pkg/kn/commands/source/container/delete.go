@@ -0,0 +1,56 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewContainerDeleteCommand is for deleting a Container source
+func NewContainerDeleteCommand(p *commands.KnParams) *cobra.Command {
+	containerDeleteCommand := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a container source",
+		Example: `
+  # Delete a ContainerSource 'src'
+  kn source container delete src`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("requires the name of the Container source to delete as single argument")
+			}
+			name := args[0]
+
+			containerClient, err := newContainerSourceClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			err = containerClient.DeleteContainerSource(name)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Container source '%s' deleted in namespace '%s'.\n", name, containerClient.Namespace())
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(containerDeleteCommand.Flags(), false)
+	return containerDeleteCommand
+}
@@ -0,0 +1,49 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
+)
+
+func TestContainerSourceDelete(t *testing.T) {
+	containerClient := clientv1alpha2.NewMockKnContainerSourceClient(t, "mynamespace")
+	recorder := containerClient.Recorder()
+	recorder.DeleteContainerSource("testsource", nil)
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "delete", "testsource")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "deleted", "mynamespace", "testsource"))
+
+	recorder.Validate()
+}
+
+func TestContainerSourceDeleteWithError(t *testing.T) {
+	containerClient := clientv1alpha2.NewMockKnContainerSourceClient(t, "mynamespace")
+	recorder := containerClient.Recorder()
+	recorder.DeleteContainerSource("testsource", errors.New("no container source testsource found"))
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "delete", "testsource")
+	assert.ErrorContains(t, err, "testsource")
+	assert.Assert(t, util.ContainsAll(out, "Usage", "testsource"))
+
+	recorder.Validate()
+}
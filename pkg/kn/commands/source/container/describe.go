@@ -0,0 +1,139 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/printers"
+)
+
+// NewContainerDescribeCommand to describe a Container source object
+func NewContainerDescribeCommand(p *commands.KnParams) *cobra.Command {
+
+	containerDescribe := &cobra.Command{
+		Use:   "describe NAME",
+		Short: "Show details of a container source",
+		Example: `
+  # Describe a container source with name 'src'
+  kn source container describe src`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn source container describe' requires name of the source as single argument")
+			}
+			name := args[0]
+
+			containerSourceClient, err := newContainerSourceClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			source, err := containerSourceClient.GetContainerSource(name)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			dw := printers.NewPrefixWriter(out)
+
+			printDetails, err := cmd.Flags().GetBool("verbose")
+			if err != nil {
+				return err
+			}
+
+			commands.WriteMetadata(dw, &source.ObjectMeta, printDetails)
+			dw.WriteLine()
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+
+			for i := range source.Spec.Template.Spec.Containers {
+				writeContainer(dw, &source.Spec.Template.Spec.Containers[i])
+			}
+			dw.WriteLine()
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+
+			writeSink(dw, &source.Spec.Sink)
+			dw.WriteLine()
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+
+			// Condition info
+			commands.WriteConditions(dw, source.Status.Conditions, printDetails)
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	flags := containerDescribe.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.BoolP("verbose", "v", false, "More output.")
+
+	return containerDescribe
+}
+
+func writeContainer(dw printers.PrefixWriter, container *corev1.Container) {
+	subWriter := dw.WriteAttribute("Container", "")
+	subWriter.WriteAttribute("Image", container.Image)
+	if len(container.Env) > 0 {
+		envWriter := subWriter.WriteAttribute("Env", "")
+		for _, env := range container.Env {
+			envWriter.WriteAttribute(env.Name, env.Value)
+		}
+	}
+	if len(container.EnvFrom) > 0 {
+		envFrom := make([]string, 0, len(container.EnvFrom))
+		for _, src := range container.EnvFrom {
+			if src.ConfigMapRef != nil {
+				envFrom = append(envFrom, "cm:"+src.ConfigMapRef.Name)
+			} else if src.SecretRef != nil {
+				envFrom = append(envFrom, "secret:"+src.SecretRef.Name)
+			}
+		}
+		subWriter.WriteAttribute("EnvFrom", strings.Join(envFrom, ", "))
+	}
+	if len(container.Command) > 0 {
+		subWriter.WriteAttribute("Command", strings.Join(container.Command, " "))
+	}
+	if len(container.Args) > 0 {
+		subWriter.WriteAttribute("Args", strings.Join(container.Args, " "))
+	}
+}
+
+func writeSink(dw printers.PrefixWriter, sink *duckv1.Destination) {
+	subWriter := dw.WriteAttribute("Sink", "")
+	ref := sink.Ref
+	if ref != nil {
+		subWriter.WriteAttribute("Name", sink.Ref.Name)
+		subWriter.WriteAttribute("Namespace", sink.Ref.Namespace)
+		subWriter.WriteAttribute("Resource", fmt.Sprintf("%s (%s)", sink.Ref.Kind, sink.Ref.APIVersion))
+	}
+	uri := sink.URI
+	if uri != nil {
+		subWriter.WriteAttribute("URI", uri.String())
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDescribeContainerSource(t *testing.T) {
+	containerClient := clientv1alpha2.NewMockKnContainerSourceClient(t, "mynamespace")
+	recorder := containerClient.Recorder()
+
+	source := createContainerSource("testsource", "docker.io/test/img", "mysvc", []corev1.EnvVar{{Name: "PERIOD", Value: "10"}}, []string{"--verbose"})
+	recorder.GetContainerSource("testsource", source, nil)
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "describe", "testsource")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Name", "testsource"))
+	assert.Assert(t, util.ContainsAll(out, "Container", "Image", "docker.io/test/img", "PERIOD", "10", "Args", "--verbose"))
+	assert.Assert(t, util.ContainsAll(out, "Sink", "Service", "mysvc"))
+	assert.Assert(t, util.ContainsNone(out, "URI"))
+
+	recorder.Validate()
+}
+
+func TestDescribeContainerSourceError(t *testing.T) {
+	containerClient := clientv1alpha2.NewMockKnContainerSourceClient(t, "mynamespace")
+	recorder := containerClient.Recorder()
+	recorder.GetContainerSource("testsource", nil, errors.New("no container source testsource found"))
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "describe", "testsource")
+	assert.ErrorContains(t, err, "testsource")
+	assert.Assert(t, util.ContainsAll(out, "Usage", "testsource"))
+
+	recorder.Validate()
+}
@@ -0,0 +1,92 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"sort"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	hprinters "knative.dev/client/pkg/printers"
+)
+
+// ContainerSourceListHandlers handles printing human readable table for `kn source container list` command's output
+func ContainerSourceListHandlers(h hprinters.PrintHandler) {
+	sourceColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Namespace", Type: "string", Description: "Namespace of the Container source", Priority: 0},
+		{Name: "Name", Type: "string", Description: "Name of the Container source", Priority: 1},
+		{Name: "Image", Type: "string", Description: "Image of the Container source", Priority: 1},
+		{Name: "Sink", Type: "string", Description: "Sink of the Container source", Priority: 1},
+		{Name: "Age", Type: "string", Description: "Age of the Container source", Priority: 1},
+		{Name: "Conditions", Type: "string", Description: "Ready state conditions", Priority: 1},
+		{Name: "Ready", Type: "string", Description: "Ready state of the Container source", Priority: 1},
+		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+	}
+	h.TableHandler(sourceColumnDefinitions, printSource)
+	h.TableHandler(sourceColumnDefinitions, printSourceList)
+}
+
+// printSource populates a single row of Container source list
+func printSource(source *v1alpha2.ContainerSource, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: source},
+	}
+
+	name := source.Name
+	image := ""
+	if len(source.Spec.Template.Spec.Containers) > 0 {
+		image = source.Spec.Template.Spec.Containers[0].Image
+	}
+	sink := flags.SinkToString(source.Spec.Sink)
+	age := commands.TranslateTimestampSince(source.CreationTimestamp)
+	conditions := commands.ConditionsValue(source.Status.Conditions)
+	ready := commands.ReadyCondition(source.Status.Conditions)
+	reason := commands.NonReadyConditionReason(source.Status.Conditions)
+
+	if options.AllNamespaces {
+		row.Cells = append(row.Cells, source.Namespace)
+	}
+
+	row.Cells = append(row.Cells, name, image, sink, age, conditions, ready, reason)
+	return []metav1beta1.TableRow{row}, nil
+}
+
+// printSourceList populates the Container source list table rows
+func printSourceList(sourceList *v1alpha2.ContainerSourceList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(sourceList.Items))
+
+	if options.AllNamespaces {
+		sort.SliceStable(sourceList.Items, func(i, j int) bool {
+			return sourceList.Items[i].GetNamespace() < sourceList.Items[j].GetNamespace()
+		})
+	} else {
+		sort.SliceStable(sourceList.Items, func(i, j int) bool {
+			return sourceList.Items[i].GetName() < sourceList.Items[j].GetName()
+		})
+	}
+
+	for i := range sourceList.Items {
+		row, err := printSource(&sourceList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row...)
+	}
+	return rows, nil
+}
@@ -0,0 +1,54 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
+)
+
+func TestListContainerSource(t *testing.T) {
+	containerClient := clientv1alpha2.NewMockKnContainerSourceClient(t)
+	recorder := containerClient.Recorder()
+
+	sourceList := &v1alpha2.ContainerSourceList{Items: []v1alpha2.ContainerSource{
+		*createContainerSource("testsource", "docker.io/test/img", "mysvc", nil, nil),
+	}}
+	recorder.ListContainerSource(sourceList, nil)
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "list")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "NAME", "IMAGE", "SINK", "AGE", "CONDITIONS", "READY", "REASON"))
+	assert.Assert(t, util.ContainsAll(out, "testsource", "docker.io/test/img", "svc:mysvc"))
+
+	recorder.Validate()
+}
+
+func TestListContainerSourceEmpty(t *testing.T) {
+	containerClient := clientv1alpha2.NewMockKnContainerSourceClient(t)
+	recorder := containerClient.Recorder()
+	recorder.ListContainerSource(&v1alpha2.ContainerSourceList{}, nil)
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "list")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "No", "Container", "source", "found"))
+
+	recorder.Validate()
+}
@@ -0,0 +1,100 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/kn/commands/service"
+	"knative.dev/client/pkg/sources/v1alpha2"
+)
+
+// NewContainerUpdateCommand for updating source
+func NewContainerUpdateCommand(p *commands.KnParams) *cobra.Command {
+	var containerFlags service.ConfigurationEditFlags
+	var sinkFlags flags.SinkFlags
+
+	cmd := &cobra.Command{
+		Use:   "update NAME",
+		Short: "Update a container source",
+		Example: `
+  # Update a ContainerSource 'src' with a different image uri
+  kn source container update src --image docker.io/sample/newimage
+
+  # Update the sink of a ContainerSource 'src' and remove the environment variable 'PERIOD'
+  kn source container update src --sink svc:othersvc --env PERIOD-`,
+
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("name of Container source required")
+			}
+			name := args[0]
+
+			containerSourceClient, err := newContainerSourceClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			source, err := containerSourceClient.GetContainerSource(name)
+			if err != nil {
+				return err
+			}
+			if source.GetDeletionTimestamp() != nil {
+				return fmt.Errorf("can't update container source %s because it has been marked for deletion", name)
+			}
+
+			b := v1alpha2.NewContainerSourceBuilderFromExisting(source)
+			podTemplate := source.Spec.Template.DeepCopy()
+			err = containerFlags.ApplyToPodTemplate(podTemplate, cmd)
+			if err != nil {
+				return err
+			}
+			b.PodSpec(podTemplate.Spec)
+
+			if cmd.Flags().Changed("sink") {
+				destination, err := sinkFlags.ResolveSink(dynamicClient, namespace)
+				if err != nil {
+					return err
+				}
+				b.Sink(*destination)
+			}
+
+			err = containerSourceClient.UpdateContainerSource(b.Build())
+			if err == nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "Container source '%s' updated in namespace '%s'.\n", name, containerSourceClient.Namespace())
+			}
+			return err
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	containerFlags.AddContainerFlags(cmd)
+	sinkFlags.Add(cmd)
+
+	return cmd
+}
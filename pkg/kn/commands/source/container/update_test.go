@@ -0,0 +1,57 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
+)
+
+func TestContainerSourceUpdate(t *testing.T) {
+	containerClient := clientv1alpha2.NewMockKnContainerSourceClient(t)
+	recorder := containerClient.Recorder()
+
+	present := createContainerSource("testsource", "docker.io/test/img", "mysvc", []corev1.EnvVar{{Name: "A", Value: "1"}}, nil)
+	updated := createContainerSource("testsource", "docker.io/test/newimg", "mysvc", []corev1.EnvVar{{Name: "B", Value: "2"}}, nil)
+	recorder.GetContainerSource("testsource", present, nil)
+	recorder.UpdateContainerSource(updated, nil)
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "update", "testsource", "--image", "docker.io/test/newimg", "--env", "A-", "--env", "B=2")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "updated", "default", "testsource"))
+
+	recorder.Validate()
+}
+
+func TestContainerSourceUpdateError(t *testing.T) {
+	containerClient := clientv1alpha2.NewMockKnContainerSourceClient(t)
+	recorder := containerClient.Recorder()
+	recorder.GetContainerSource("testsource", nil, errors.New("no container source testsource found"))
+
+	_, err := executeContainerSourceCommand(containerClient, nil, "update")
+	assert.ErrorContains(t, err, "name")
+
+	out, err := executeContainerSourceCommand(containerClient, nil, "update", "testsource", "--image", "docker.io/test/newimg")
+	assert.ErrorContains(t, err, "testsource")
+	assert.Assert(t, util.ContainsAll(out, "Usage", "testsource"))
+
+	recorder.Validate()
+}
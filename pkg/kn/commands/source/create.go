@@ -0,0 +1,128 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+)
+
+var createExample = `
+  # Create a KafkaSource 'mykafka' reading from topic 'orders' and sending events to service 'mysvc'
+  kn source create KafkaSource mykafka --sink svc:mysvc \
+    --spec bootstrapServers=my-cluster-kafka-bootstrap.kafka:9092 --spec topics=orders
+
+  # Show the spec fields which can be set for KafkaSources
+  kn source types describe KafkaSource`
+
+// NewCreateCommand defines and processes `kn source create`
+func NewCreateCommand(p *commands.KnParams) *cobra.Command {
+	var sinkFlags flags.SinkFlags
+	var specs []string
+
+	createCommand := &cobra.Command{
+		Use:     "create TYPE NAME --sink SINK --spec FIELD=VALUE",
+		Short:   "Create an event source of any type",
+		Example: createExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("'kn source create' requires the source type and the source name as arguments TYPE NAME")
+			}
+			sourceType, name := args[0], args[1]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			crd, err := dynamicClient.GetSourceType(sourceType)
+			if err != nil {
+				return err
+			}
+			schema, err := dynamic.SpecSchemaFromUnstructured(crd)
+			if err != nil {
+				return err
+			}
+
+			spec := map[string]interface{}{}
+			for _, s := range specs {
+				parts := strings.SplitN(s, "=", 2)
+				if len(parts) != 2 || parts[0] == "" {
+					return fmt.Errorf("invalid --spec '%s', expected FIELD=VALUE", s)
+				}
+				if err := setSpecField(schema, spec, parts[0], parts[1]); err != nil {
+					return err
+				}
+			}
+
+			destination, err := sinkFlags.ResolveSink(dynamicClient, namespace)
+			if err != nil {
+				return err
+			}
+			if destination != nil {
+				sink, err := runtime.DefaultUnstructuredConverter.ToUnstructured(destination)
+				if err != nil {
+					return err
+				}
+				spec["sink"] = sink
+			}
+
+			if schema != nil {
+				if err := schema.Validate(spec); err != nil {
+					return fmt.Errorf("cannot create source '%s' of type '%s': %v, use 'kn source types describe %s' to show all spec fields", name, sourceType, err, sourceType)
+				}
+			}
+
+			source := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+			source.SetName(name)
+			source.SetNamespace(namespace)
+			err = dynamicClient.CreateSource(sourceType, source)
+			if err != nil {
+				return fmt.Errorf("cannot create source '%s' of type '%s' in namespace '%s' because: %s", name, sourceType, namespace, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s '%s' created in namespace '%s'.\n", source.GetKind(), name, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(createCommand.Flags(), false)
+	sinkFlags.Add(createCommand)
+	createCommand.Flags().StringArrayVar(&specs, "spec", []string{},
+		"Spec field to set, given as FIELD=VALUE with FIELD being the path of the field below .spec separated by '.' "+
+			"(e.g. --spec replicas=2). Values are converted to the type declared in the source's schema, list values "+
+			"are separated by ','. You can use this flag multiple times.")
+	return createCommand
+}
+
+// setSpecField sets the spec field with the value converted according to the
+// schema. Without a schema, the value is set as string without any validation.
+func setSpecField(schema *dynamic.SpecSchema, spec map[string]interface{}, path string, value string) error {
+	if schema != nil {
+		return schema.Set(spec, path, value)
+	}
+	return unstructured.SetNestedField(spec, value, strings.Split(path, ".")...)
+}
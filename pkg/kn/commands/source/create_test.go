@@ -0,0 +1,129 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+)
+
+func TestSourceCreate(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, _, buf := commands.CreateDynamicTestKnCommand(NewSourceCommand(knParams), knParams,
+		newSourceCRDObjWithSchema("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"),
+		newServiceUnstructuredObj("mysvc"),
+	)
+	cmd.SetArgs([]string{"source", "create", "KafkaSource", "k1", "--sink", "svc:mysvc",
+		"--spec", "bootstrapServers=a:9092,b:9092", "--spec", "topics=t1", "--spec", "replicas=2"})
+	assert.NilError(t, cmd.Execute())
+	assert.Assert(t, util.ContainsAll(buf.String(), "KafkaSource", "'k1'", "created", "current"))
+
+	dynamicClient, _ := knParams.NewDynamicClient("current")
+	source, err := dynamicClient.GetSource("kafkasources", "k1")
+	assert.NilError(t, err)
+	assert.Equal(t, source.GetAPIVersion(), "sources.knative.dev/v1alpha1")
+	replicas, _, _ := unstructured.NestedInt64(source.Object, "spec", "replicas")
+	assert.Equal(t, replicas, int64(2))
+	servers, _, _ := unstructured.NestedStringSlice(source.Object, "spec", "bootstrapServers")
+	assert.DeepEqual(t, servers, []string{"a:9092", "b:9092"})
+	sinkName, _, _ := unstructured.NestedString(source.Object, "spec", "sink", "ref", "name")
+	assert.Equal(t, sinkName, "mysvc")
+}
+
+func TestSourceCreateWithoutSchema(t *testing.T) {
+	output, err := sourceFakeCmd([]string{"source", "create", "PingSource", "p1", "--spec", "schedule=* * * * *"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(strings.Join(output, "\n"), "PingSource", "'p1'", "created"))
+}
+
+func TestSourceCreateError(t *testing.T) {
+	crd := newSourceCRDObjWithSchema("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource")
+
+	_, err := sourceFakeCmd([]string{"source", "create", "KafkaSource"}, crd)
+	assert.ErrorContains(t, err, "TYPE NAME")
+
+	_, err = sourceFakeCmd([]string{"source", "create", "GitHubSource", "g1"}, crd)
+	assert.ErrorContains(t, err, "no source type 'GitHubSource' found")
+
+	_, err = sourceFakeCmd([]string{"source", "create", "KafkaSource", "k1", "--spec", "topics"}, crd)
+	assert.ErrorContains(t, err, "expected FIELD=VALUE")
+
+	_, err = sourceFakeCmd([]string{"source", "create", "KafkaSource", "k1", "--spec", "topic=t1"}, crd)
+	assert.ErrorContains(t, err, "unknown spec field 'topic'")
+
+	_, err = sourceFakeCmd([]string{"source", "create", "KafkaSource", "k1", "--spec", "replicas=two"}, crd)
+	assert.ErrorContains(t, err, "invalid value 'two'")
+
+	_, err = sourceFakeCmd([]string{"source", "create", "KafkaSource", "k1", "--spec", "topics=t1"}, crd)
+	assert.ErrorContains(t, err, "missing required spec field(s): bootstrapServers")
+	assert.ErrorContains(t, err, "kn source types describe KafkaSource")
+
+	_, err = sourceFakeCmd([]string{"source", "create", "KafkaSource", "k1", "--spec", "topics=t1",
+		"--spec", "bootstrapServers=a:9092", "--sink", "svc:absent"}, crd)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func newSourceCRDObjWithSchema(name, group, version, kind string) *unstructured.Unstructured {
+	crd := newSourceCRDObjWithSpec(name, group, version, kind)
+	unstructured.SetNestedMap(crd.Object, map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"type":        "object",
+				"description": "KafkaSourceSpec defines the desired state of the KafkaSource.",
+				"required":    []interface{}{"bootstrapServers", "topics"},
+				"properties": map[string]interface{}{
+					"bootstrapServers": map[string]interface{}{
+						"type":        "array",
+						"description": "Bootstrap servers are the Kafka servers the consumer will connect to.",
+						"items":       map[string]interface{}{"type": "string"},
+					},
+					"topics": map[string]interface{}{
+						"type":        "array",
+						"description": "Topic topics to consume messages from\nMultiple topics are separated by ','.",
+						"items":       map[string]interface{}{"type": "string"},
+					},
+					"replicas": map[string]interface{}{"type": "integer"},
+					"sink": map[string]interface{}{
+						"type":                                 "object",
+						"x-kubernetes-preserve-unknown-fields": true,
+					},
+				},
+			},
+		},
+	}, "spec", "validation", "openAPIV3Schema")
+	return crd
+}
+
+func newServiceUnstructuredObj(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "serving.knative.dev/v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"namespace": "current",
+				"name":      name,
+			},
+		},
+	}
+}
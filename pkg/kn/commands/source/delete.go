@@ -0,0 +1,64 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+var deleteExample = `
+  # Delete the PingSource 'myping'
+  kn source delete PingSource/myping
+
+  # Delete the KafkaSource 'mykafka' in namespace 'events'
+  kn source delete kafkasources/mykafka -n events`
+
+// NewDeleteCommand defines and processes `kn source delete`
+func NewDeleteCommand(p *commands.KnParams) *cobra.Command {
+	deleteCommand := &cobra.Command{
+		Use:     "delete TYPE/NAME",
+		Short:   "Delete an event source of any type",
+		Example: deleteExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sourceType, name, err := parseSourceTypeAndName("delete", args)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			err = dynamicClient.DeleteSource(sourceType, name)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Source '%s/%s' deleted in namespace '%s'.\n", sourceType, name, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(deleteCommand.Flags(), false)
+	return deleteCommand
+}
@@ -0,0 +1,44 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestSourceDelete(t *testing.T) {
+	output, err := sourceFakeCmd([]string{"source", "delete", "kafkasource/k1"},
+		newSourceCRDObjWithSpec("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"),
+		newSourceUnstructuredObj("k1", "sources.knative.dev/v1alpha1", "KafkaSource"),
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(strings.Join(output, "\n"), "Source", "kafkasource/k1", "deleted", "current"))
+}
+
+func TestSourceDeleteError(t *testing.T) {
+	_, err := sourceFakeCmd([]string{"source", "delete", "KafkaSource/"})
+	assert.ErrorContains(t, err, "invalid source")
+
+	_, err = sourceFakeCmd([]string{"source", "delete", "KafkaSource/k2"},
+		newSourceCRDObjWithSpec("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"),
+		newSourceUnstructuredObj("k1", "sources.knative.dev/v1alpha1", "KafkaSource"),
+	)
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,125 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/source/duck"
+	"knative.dev/client/pkg/printers"
+)
+
+var describeExample = `
+  # Describe the PingSource 'myping'
+  kn source describe PingSource/myping
+
+  # Describe the KafkaSource 'mykafka' with more details
+  kn source describe kafkasources/mykafka --verbose`
+
+// NewDescribeCommand defines and processes `kn source describe`
+func NewDescribeCommand(p *commands.KnParams) *cobra.Command {
+	describeCommand := &cobra.Command{
+		Use:     "describe TYPE/NAME",
+		Short:   "Show details of an event source of any type",
+		Example: describeExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sourceType, name, err := parseSourceTypeAndName("describe", args)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			u, err := dynamicClient.GetSource(sourceType, name)
+			if err != nil {
+				return err
+			}
+			source, err := duck.ToDuckSource(u)
+			if err != nil {
+				return err
+			}
+
+			printDetails, err := cmd.Flags().GetBool("verbose")
+			if err != nil {
+				return err
+			}
+
+			dw := printers.NewPrefixWriter(cmd.OutOrStdout())
+			commands.WriteMetadata(dw, &source.ObjectMeta, printDetails)
+			dw.WriteAttribute("Type", fmt.Sprintf("%s (%s)", u.GetKind(), u.GetAPIVersion()))
+			dw.WriteLine()
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+
+			writeSink(dw, &source.Spec.Sink)
+			dw.WriteLine()
+			if err := dw.Flush(); err != nil {
+				return err
+			}
+
+			if source.Spec.CloudEventOverrides != nil && source.Spec.CloudEventOverrides.Extensions != nil {
+				writeCeOverrides(dw, source.Spec.CloudEventOverrides.Extensions)
+				dw.WriteLine()
+				if err := dw.Flush(); err != nil {
+					return err
+				}
+			}
+
+			commands.WriteConditions(dw, source.Status.Conditions, printDetails)
+			return dw.Flush()
+		},
+	}
+	flags := describeCommand.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.BoolP("verbose", "v", false, "More output.")
+	return describeCommand
+}
+
+func writeSink(dw printers.PrefixWriter, sink *duckv1.Destination) {
+	subWriter := dw.WriteAttribute("Sink", "")
+	if sink.Ref != nil {
+		subWriter.WriteAttribute("Name", sink.Ref.Name)
+		subWriter.WriteAttribute("Namespace", sink.Ref.Namespace)
+		subWriter.WriteAttribute("Resource", fmt.Sprintf("%s (%s)", sink.Ref.Kind, sink.Ref.APIVersion))
+	}
+	if sink.URI != nil {
+		subWriter.WriteAttribute("URI", sink.URI.String())
+	}
+}
+
+func writeCeOverrides(dw printers.PrefixWriter, ceOverrides map[string]string) {
+	subDw := dw.WriteAttribute("CloudEvent Overrides", "")
+	var keys []string
+	for k := range ceOverrides {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		subDw.WriteAttribute(k, ceOverrides[k])
+	}
+}
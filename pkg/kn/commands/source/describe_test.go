@@ -0,0 +1,72 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestSourceDescribe(t *testing.T) {
+	source := newSourceUnstructuredObj("k1", "sources.knative.dev/v1alpha1", "KafkaSource")
+	source.Object["spec"].(map[string]interface{})["ceOverrides"] = map[string]interface{}{
+		"extensions": map[string]interface{}{
+			"foo": "bar",
+		},
+	}
+	output, err := sourceFakeCmd([]string{"source", "describe", "KafkaSource/k1"},
+		newSourceCRDObjWithSpec("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"),
+		source,
+	)
+	assert.NilError(t, err)
+	out := strings.Join(output, "\n")
+	assert.Assert(t, util.ContainsAll(out, "Name:", "k1", "Type:", "KafkaSource (sources.knative.dev/v1alpha1)"))
+	assert.Assert(t, util.ContainsAll(out, "Sink:", "foo", "Service"))
+	assert.Assert(t, util.ContainsAll(out, "CloudEvent Overrides:", "foo:", "bar"))
+	assert.Assert(t, util.ContainsAll(out, "Conditions:", "Ready"))
+}
+
+func TestSourceDescribeByResource(t *testing.T) {
+	output, err := sourceFakeCmd([]string{"source", "describe", "pingsources.sources.knative.dev/p1"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+		newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource"),
+	)
+	assert.NilError(t, err)
+	out := strings.Join(output, "\n")
+	assert.Assert(t, util.ContainsAll(out, "p1", "PingSource", "Sink:"))
+	assert.Assert(t, util.ContainsNone(out, "CloudEvent Overrides"))
+}
+
+func TestSourceDescribeError(t *testing.T) {
+	_, err := sourceFakeCmd([]string{"source", "describe"})
+	assert.ErrorContains(t, err, "TYPE/NAME")
+
+	_, err = sourceFakeCmd([]string{"source", "describe", "k1"})
+	assert.ErrorContains(t, err, "invalid source 'k1'")
+
+	_, err = sourceFakeCmd([]string{"source", "describe", "KafkaSource/k1"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+	)
+	assert.ErrorContains(t, err, "no source type 'KafkaSource' found")
+
+	_, err = sourceFakeCmd([]string{"source", "describe", "PingSource/p2"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+	)
+	assert.ErrorContains(t, err, "not found")
+}
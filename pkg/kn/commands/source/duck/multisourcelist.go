@@ -97,6 +97,17 @@ func ToSourceList(uList *unstructured.UnstructuredList) *SourceList {
 	return &dsl
 }
 
+// ToDuckSource converts an eventing source object received as Unstructured
+// object into the common duck typed source, which gives access to the sink,
+// the CloudEvent overrides and the status conditions of any source
+func ToDuckSource(u *unstructured.Unstructured) (*duckv1.Source, error) {
+	var source duckv1.Source
+	if err := duck.FromUnstructured(u, &source); err != nil {
+		return nil, fmt.Errorf("cannot convert %s '%s' to a duck typed source: %v", u.GetKind(), u.GetName(), err)
+	}
+	return &source, nil
+}
+
 func getSourceTypeName(source *unstructured.Unstructured) string {
 	return fmt.Sprintf("%s%s.%s",
 		strings.ToLower(source.GetKind()),
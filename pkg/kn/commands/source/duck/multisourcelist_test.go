@@ -71,6 +71,19 @@ func TestConditionsFromUnstructured(t *testing.T) {
 
 }
 
+func TestToDuckSource(t *testing.T) {
+	s, err := ToDuckSource(newSourceUnstructuredObjWithSink("k1",
+		"sources.knative.dev/v1alpha1", "KafkaSource"))
+	assert.NilError(t, err)
+	assert.Equal(t, s.Name, "k1")
+	assert.Check(t, s.Spec.Sink.Ref != nil)
+	assert.Equal(t, s.Spec.Sink.Ref.Name, "foo")
+
+	_, err = ToDuckSource(newSourceUnstructuredObjWithIncorrectSink("k1",
+		"sources.knative.dev/v1alpha1", "KafkaSource"))
+	assert.ErrorContains(t, err, "KafkaSource 'k1'")
+}
+
 func newSourceUnstructuredObjWithSink(name, apiVersion, kind string) *unstructured.Unstructured {
 	return &unstructured.Unstructured{
 		Object: map[string]interface{}{
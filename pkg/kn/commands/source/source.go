@@ -15,11 +15,15 @@
 package source
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/source/apiserver"
 	"knative.dev/client/pkg/kn/commands/source/binding"
+	"knative.dev/client/pkg/kn/commands/source/container"
 	"knative.dev/client/pkg/kn/commands/source/ping"
 )
 
@@ -30,8 +34,26 @@ func NewSourceCommand(p *commands.KnParams) *cobra.Command {
 	}
 	sourceCmd.AddCommand(NewListTypesCommand(p))
 	sourceCmd.AddCommand(NewListCommand(p))
+	sourceCmd.AddCommand(NewTypesCommand(p))
+	sourceCmd.AddCommand(NewCreateCommand(p))
+	sourceCmd.AddCommand(NewDescribeCommand(p))
+	sourceCmd.AddCommand(NewDeleteCommand(p))
 	sourceCmd.AddCommand(apiserver.NewAPIServerCommand(p))
 	sourceCmd.AddCommand(ping.NewPingCommand(p))
 	sourceCmd.AddCommand(binding.NewBindingCommand(p))
+	sourceCmd.AddCommand(container.NewContainerCommand(p))
 	return sourceCmd
 }
+
+// parseSourceTypeAndName splits the single TYPE/NAME argument of the generic
+// source commands into the source type and the source name
+func parseSourceTypeAndName(command string, args []string) (string, string, error) {
+	if len(args) != 1 {
+		return "", "", fmt.Errorf("'kn source %s' requires the source given as single argument TYPE/NAME", command)
+	}
+	parts := strings.SplitN(args[0], "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid source '%s' given to 'kn source %s', expected TYPE/NAME (e.g. 'PingSource/myping')", args[0], command)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,132 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/printers"
+)
+
+// NewTypesCommand groups the commands for inspecting source types
+func NewTypesCommand(p *commands.KnParams) *cobra.Command {
+	typesCommand := &cobra.Command{
+		Use:   "types",
+		Short: "Inspect event source types",
+	}
+	typesCommand.AddCommand(NewTypesDescribeCommand(p))
+	return typesCommand
+}
+
+// NewTypesDescribeCommand defines and processes `kn source types describe`
+func NewTypesDescribeCommand(p *commands.KnParams) *cobra.Command {
+	typesDescribeCommand := &cobra.Command{
+		Use:   "describe TYPE",
+		Short: "Show the spec fields of an event source type",
+		Example: `
+  # Show the spec fields which can be set with 'kn source create' for KafkaSources
+  kn source types describe KafkaSource`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'kn source types describe' requires the source type as single argument")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			crd, err := dynamicClient.GetSourceType(args[0])
+			if err != nil {
+				return err
+			}
+			schema, err := dynamic.SpecSchemaFromUnstructured(crd)
+			if err != nil {
+				return err
+			}
+			printDetails, err := cmd.Flags().GetBool("verbose")
+			if err != nil {
+				return err
+			}
+
+			kind, _, err := unstructured.NestedString(crd.UnstructuredContent(), "spec", "names", "kind")
+			if err != nil {
+				return err
+			}
+			description := sourceTypeDescription[kind]
+			if description == "" && schema != nil {
+				description = firstLine(schema.Description())
+			}
+
+			dw := printers.NewPrefixWriter(cmd.OutOrStdout())
+			dw.WriteAttribute("Name", crd.GetName())
+			dw.WriteAttribute("Kind", kind)
+			if description != "" {
+				dw.WriteAttribute("Description", description)
+			}
+			dw.WriteLine()
+			if schema == nil {
+				dw.WriteAttribute("Spec Fields", "no schema available")
+				return dw.Flush()
+			}
+			writeSpecFields(dw, schema.Fields(), printDetails)
+			return dw.Flush()
+		},
+	}
+	flags := typesDescribeCommand.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.BoolP("verbose", "v", false, "Show full field descriptions.")
+	return typesDescribeCommand
+}
+
+func writeSpecFields(dw printers.PrefixWriter, fields []dynamic.SpecField, printDetails bool) {
+	section := dw.WriteAttribute("Spec Fields", "")
+	pathLen, typeLen := len("NAME"), len("TYPE")
+	for _, field := range fields {
+		if len(field.Path) > pathLen {
+			pathLen = len(field.Path)
+		}
+		if len(field.Type) > typeLen {
+			typeLen = len(field.Type)
+		}
+	}
+	format := "%-" + strconv.Itoa(pathLen) + "s %-" + strconv.Itoa(typeLen) + "s %-8s %s\n"
+	section.Writef(format, "NAME", "TYPE", "REQUIRED", "DESCRIPTION")
+	for _, field := range fields {
+		required := ""
+		if field.Required {
+			required = "yes"
+		}
+		description := field.Description
+		if !printDetails {
+			description = firstLine(description)
+		}
+		section.Writef(format, field.Path, field.Type, required, description)
+	}
+}
+
+func firstLine(s string) string {
+	return strings.SplitN(strings.TrimSpace(s), "\n", 2)[0]
+}
@@ -0,0 +1,65 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestSourceTypesDescribe(t *testing.T) {
+	output, err := sourceFakeCmd([]string{"source", "types", "describe", "kafkasources"},
+		newSourceCRDObjWithSchema("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"),
+	)
+	assert.NilError(t, err)
+	out := strings.Join(output, "\n")
+	assert.Assert(t, util.ContainsAll(out, "Name:", "kafkasources", "Kind:", "KafkaSource"))
+	assert.Assert(t, util.ContainsAll(out, "Description:", "KafkaSourceSpec defines the desired state"))
+	assert.Assert(t, util.ContainsAll(out, "Spec Fields:", "NAME", "TYPE", "REQUIRED", "DESCRIPTION"))
+	assert.Assert(t, util.ContainsAll(out, "bootstrapServers", "[]string", "yes", "Bootstrap servers"))
+	assert.Assert(t, util.ContainsAll(out, "replicas", "integer"))
+	assert.Assert(t, util.ContainsAll(out, "topics", "Topic topics to consume messages from"))
+	assert.Assert(t, util.ContainsNone(out, "Multiple topics"))
+
+	output, err = sourceFakeCmd([]string{"source", "types", "describe", "kafkasources", "--verbose"},
+		newSourceCRDObjWithSchema("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"),
+	)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(strings.Join(output, "\n"), "Multiple topics"))
+}
+
+func TestSourceTypesDescribeWithoutSchema(t *testing.T) {
+	output, err := sourceFakeCmd([]string{"source", "types", "describe", "PingSource"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+	)
+	assert.NilError(t, err)
+	out := strings.Join(output, "\n")
+	assert.Assert(t, util.ContainsAll(out, "PingSource", "Send periodically ping events to a sink"))
+	assert.Assert(t, util.ContainsAll(out, "Spec Fields:", "no schema available"))
+}
+
+func TestSourceTypesDescribeError(t *testing.T) {
+	_, err := sourceFakeCmd([]string{"source", "types", "describe"})
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = sourceFakeCmd([]string{"source", "types", "describe", "KafkaSource"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+	)
+	assert.ErrorContains(t, err, "no source type 'KafkaSource' found")
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+)
+
+var createExample = `
+  # Create a subscription 'sub0' for channel 'pipe' and forward events to the service 'mysvc'
+  kn subscription create sub0 --channel pipe --sink svc:mysvc
+
+  # Create a subscription which sends the replies of 'mysvc' to the default broker
+  # and undeliverable events to the service 'dlq'
+  kn subscription create sub1 --channel pipe --sink svc:mysvc --sink-reply broker:default --sink-dead-letter svc:dlq`
+
+// NewSubscriptionCreateCommand is for creating a Subscription
+func NewSubscriptionCreateCommand(p *commands.KnParams) *cobra.Command {
+	var channelFlags ChannelRefFlags
+	var subscriberFlags, replyFlags, deadLetterFlags flags.SinkFlags
+
+	cmd := &cobra.Command{
+		Use:     "create NAME --channel CHANNEL [--sink SINK]",
+		Short:   "Create a subscription",
+		Example: createExample,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("'subscription create' requires the subscription name given as single argument")
+			}
+			name := args[0]
+
+			channelRef, err := channelFlags.Parse()
+			if err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			subscriber, err := subscriberFlags.ResolveSink(dynamicClient, namespace)
+			if err != nil {
+				return err
+			}
+			reply, err := replyFlags.ResolveSink(dynamicClient, namespace)
+			if err != nil {
+				return err
+			}
+			deadLetterSink, err := deadLetterFlags.ResolveSink(dynamicClient, namespace)
+			if err != nil {
+				return err
+			}
+
+			messagingClient, err := p.NewMessagingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			subscription := knmessagingv1beta1.NewSubscriptionBuilder(name).
+				Namespace(namespace).
+				Channel(channelRef).
+				Subscriber(subscriber).
+				Reply(reply).
+				DeadLetterSink(deadLetterSink).
+				Build()
+
+			err = messagingClient.SubscriptionsClient().CreateSubscription(subscription)
+			if err != nil {
+				return fmt.Errorf(
+					"cannot create subscription '%s' in namespace '%s' "+
+						"because: %s", name, namespace, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Subscription '%s' created in namespace '%s'.\n", name, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	channelFlags.Add(cmd)
+	subscriberFlags.AddWithFlagName(cmd, "sink", "s", "Addressable sink to which the events of the channel are delivered")
+	replyFlags.AddWithFlagName(cmd, "sink-reply", "", "Addressable sink to which the replies of the subscriber are sent")
+	deadLetterFlags.AddWithFlagName(cmd, "sink-dead-letter", "", "Addressable sink to which events are sent which could not be delivered")
+	return cmd
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestCreateSubscriptionErrorCase(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+
+	_, err := executeSubscriptionCommand(cClient, dynamicClient, "create", "--channel", "pipe")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeSubscriptionCommand(cClient, dynamicClient, "create", "sub0")
+	assert.ErrorContains(t, err, "--channel")
+
+	_, err = executeSubscriptionCommand(cClient, dynamicClient, "create", "sub0", "--channel", "foo:pipe")
+	assert.ErrorContains(t, err, "invalid channel")
+
+	_, err = executeSubscriptionCommand(cClient, dynamicClient, "create", "sub0", "--channel", "pipe", "--sink", "svc:absent")
+	assert.ErrorContains(t, err, "\"absent\" not found")
+
+	_, err = executeSubscriptionCommand(cClient, dynamicClient, "create", "sub0", "--channel", "pipe", "--sink-dead-letter", "broker:absent")
+	assert.ErrorContains(t, err, "\"absent\" not found")
+}
+
+func TestCreateSubscription(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default",
+		createServiceObject("mysvc"), createServiceObject("dlq"), createBrokerObject("default"))
+
+	cRecorder := cClient.SubscriptionsRecorder()
+	cRecorder.CreateSubscription(createSubscription("sub0", "pipe", "mysvc", "default", "dlq"), nil)
+
+	out, err := executeSubscriptionCommand(cClient, dynamicClient, "create", "sub0",
+		"--channel", "pipe",
+		"--sink", "svc:mysvc",
+		"--sink-reply", "broker:default",
+		"--sink-dead-letter", "svc:dlq")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Subscription", "sub0", "created", "default"))
+
+	cRecorder.Validate()
+}
+
+func TestCreateSubscriptionWithChannelType(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", createServiceObject("mysvc"))
+
+	subscription := createSubscription("sub0", "pipe", "mysvc", "", "")
+	subscription.Spec.Channel = corev1.ObjectReference{APIVersion: "messaging.knative.dev/v1beta1", Kind: "InMemoryChannel", Name: "pipe"}
+	cRecorder := cClient.SubscriptionsRecorder()
+	cRecorder.CreateSubscription(subscription, nil)
+
+	_, err := executeSubscriptionCommand(cClient, dynamicClient, "create", "sub0",
+		"--channel", "messaging.knative.dev:v1beta1:InMemoryChannel:pipe", "--sink", "mysvc")
+	assert.NilError(t, err)
+
+	cRecorder.Validate()
+}
+
+func TestCreateSubscriptionError(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", createServiceObject("mysvc"))
+
+	cRecorder := cClient.SubscriptionsRecorder()
+	cRecorder.CreateSubscription(createSubscription("sub0", "pipe", "mysvc", "", ""), fmt.Errorf("boom"))
+
+	_, err := executeSubscriptionCommand(cClient, dynamicClient, "create", "sub0", "--channel", "pipe", "--sink", "mysvc")
+	assert.ErrorContains(t, err, "cannot create subscription 'sub0'")
+	assert.ErrorContains(t, err, "boom")
+
+	cRecorder.Validate()
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDeleteSubscription(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.SubscriptionsRecorder()
+	cRecorder.DeleteSubscription("sub0", nil)
+
+	out, err := executeSubscriptionCommand(cClient, dynamicfake.CreateFakeKnDynamicClient("default"), "delete", "sub0")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Subscription", "sub0", "deleted", "default"))
+
+	cRecorder.Validate()
+}
+
+func TestDeleteSubscriptionErrorCase(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.SubscriptionsRecorder()
+	cRecorder.DeleteSubscription("sub0", fmt.Errorf("subscription sub0 not found"))
+
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+	_, err := executeSubscriptionCommand(cClient, dynamicClient, "delete")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeSubscriptionCommand(cClient, dynamicClient, "delete", "sub0")
+	assert.ErrorContains(t, err, "not found")
+
+	cRecorder.Validate()
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/printers"
+)
+
+var describeExample = `
+  # Describe a subscription 'sub0'
+  kn subscription describe sub0`
+
+// NewSubscriptionDescribeCommand returns a new command for describing a Subscription
+func NewSubscriptionDescribeCommand(p *commands.KnParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "describe NAME",
+		Short:   "Show details of a subscription",
+		Example: describeExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'subscription describe' requires the subscription name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			messagingClient, err := p.NewMessagingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			subscription, err := messagingClient.SubscriptionsClient().GetSubscription(name)
+			if err != nil {
+				return err
+			}
+			return describeSubscription(cmd.OutOrStdout(), subscription, false)
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	return cmd
+}
+
+func describeSubscription(out io.Writer, subscription *v1beta1.Subscription, printDetails bool) error {
+	dw := printers.NewPrefixWriter(out)
+	commands.WriteMetadata(dw, &subscription.ObjectMeta, printDetails)
+	dw.WriteAttribute("Channel", channelRefToString(subscription.Spec.Channel))
+	dw.WriteAttribute("Subscriber", destinationToString(subscription.Spec.Subscriber))
+	dw.WriteAttribute("Reply", destinationToString(subscription.Spec.Reply))
+	dw.WriteAttribute("DeadLetterSink", destinationToString(deadLetterSink(subscription)))
+	dw.WriteLine()
+	commands.WriteConditions(dw, subscription.Status.Conditions, printDetails)
+	if err := dw.Flush(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// destinationToString returns the string representation of an optional destination
+func destinationToString(destination *duckv1.Destination) string {
+	if destination == nil {
+		return ""
+	}
+	return flags.SinkToString(*destination)
+}
+
+func deadLetterSink(subscription *v1beta1.Subscription) *duckv1.Destination {
+	if subscription.Spec.Delivery == nil {
+		return nil
+	}
+	return subscription.Spec.Delivery.DeadLetterSink
+}
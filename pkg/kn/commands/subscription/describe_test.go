@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	"gotest.tools/assert/cmp"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDescribeSubscription(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.SubscriptionsRecorder()
+
+	subscription := createSubscription("sub0", "pipe", "mysvc", "default", "dlq")
+	subscription.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: "True"}}
+	cRecorder.GetSubscription("sub0", subscription, nil)
+
+	out, err := executeSubscriptionCommand(cClient, dynamicfake.CreateFakeKnDynamicClient("default"), "describe", "sub0")
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Regexp("Name:\\s+sub0", out))
+	assert.Assert(t, cmp.Regexp("Channel:\\s+Channel:pipe \\(messaging.knative.dev/v1beta1\\)", out))
+	assert.Assert(t, cmp.Regexp("Subscriber:\\s+svc:mysvc", out))
+	assert.Assert(t, cmp.Regexp("Reply:\\s+broker:default", out))
+	assert.Assert(t, cmp.Regexp("DeadLetterSink:\\s+svc:dlq", out))
+	assert.Assert(t, util.ContainsAll(out, "Conditions:", "Ready"))
+
+	cRecorder.Validate()
+}
+
+func TestDescribeSubscriptionErrorCase(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.SubscriptionsRecorder()
+	cRecorder.GetSubscription("sub0", nil, fmt.Errorf("subscription sub0 not found"))
+
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
+	_, err := executeSubscriptionCommand(cClient, dynamicClient, "describe")
+	assert.ErrorContains(t, err, "single argument")
+
+	_, err = executeSubscriptionCommand(cClient, dynamicClient, "describe", "sub0")
+	assert.ErrorContains(t, err, "not found")
+
+	cRecorder.Validate()
+}
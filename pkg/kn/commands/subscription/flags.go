@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ChannelRefFlags holds the channel given with --channel
+type ChannelRefFlags struct {
+	channel string
+}
+
+// Add adds the --channel flag to the given command
+func (c *ChannelRefFlags) Add(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&c.channel, "channel", "",
+		"Name of the channel to subscribe to. "+
+			"Use the format 'Group:Version:Kind:Name' to refer to a channel implementation directly, "+
+			"e.g. 'messaging.knative.dev:v1beta1:InMemoryChannel:pipe'.")
+}
+
+// Parse returns the object reference for the channel given with --channel
+func (c *ChannelRefFlags) Parse() (*corev1.ObjectReference, error) {
+	if c.channel == "" {
+		return nil, fmt.Errorf("missing required flag '--channel'")
+	}
+	parts := strings.Split(c.channel, ":")
+	switch {
+	case len(parts) == 1:
+		return &corev1.ObjectReference{
+			APIVersion: "messaging.knative.dev/v1beta1",
+			Kind:       "Channel",
+			Name:       parts[0],
+		}, nil
+	case len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "":
+		return &corev1.ObjectReference{
+			APIVersion: parts[0] + "/" + parts[1],
+			Kind:       parts[2],
+			Name:       parts[3],
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid channel '%s', use either the name of a channel or the format 'Group:Version:Kind:Name'", c.channel)
+	}
+}
+
+// channelRefToString prepares a channel reference for output
+func channelRefToString(ref corev1.ObjectReference) string {
+	return fmt.Sprintf("%s:%s (%s)", ref.Kind, ref.Name, ref.APIVersion)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	hprinters "knative.dev/client/pkg/printers"
+)
+
+var listExample = `
+  # List all subscriptions
+  kn subscription list
+
+  # List subscriptions in YAML format
+  kn subscription list -o yaml`
+
+// NewSubscriptionListCommand is for listing subscription objects
+func NewSubscriptionListCommand(p *commands.KnParams) *cobra.Command {
+	subscriptionListFlags := flags.NewListPrintFlags(ListHandlers)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List subscriptions",
+		Aliases: []string{"ls"},
+		Example: listExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			messagingClient, err := p.NewMessagingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			subscriptionList, err := messagingClient.SubscriptionsClient().ListSubscription()
+			if err != nil {
+				return err
+			}
+			if len(subscriptionList.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No subscriptions found.\n")
+				return nil
+			}
+
+			// empty namespace indicates all-namespaces flag is specified
+			if namespace == "" {
+				subscriptionListFlags.EnsureWithNamespace()
+			}
+
+			return subscriptionListFlags.Print(subscriptionList, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), true)
+	subscriptionListFlags.AddFlags(cmd)
+	return cmd
+}
+
+// ListHandlers adds print handlers for subscription list command
+func ListHandlers(h hprinters.PrintHandler) {
+	subscriptionColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Namespace", Type: "string", Description: "Namespace of the Subscription instance", Priority: 0},
+		{Name: "Name", Type: "string", Description: "Name of the Subscription instance", Priority: 1},
+		{Name: "Channel", Type: "string", Description: "Channel of the Subscription instance", Priority: 1},
+		{Name: "Subscriber", Type: "string", Description: "Subscriber of the Subscription instance", Priority: 1},
+		{Name: "Reply", Type: "string", Description: "Reply sink of the Subscription instance", Priority: 1},
+		{Name: "Dead Letter Sink", Type: "string", Description: "Dead letter sink of the Subscription instance", Priority: 1},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the Subscription instance", Priority: 1},
+		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+	}
+	h.TableHandler(subscriptionColumnDefinitions, printSubscription)
+	h.TableHandler(subscriptionColumnDefinitions, printSubscriptionList)
+}
+
+func printSubscriptionList(subscriptionList *v1beta1.SubscriptionList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(subscriptionList.Items))
+	for i := range subscriptionList.Items {
+		r, err := printSubscription(&subscriptionList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func printSubscription(subscription *v1beta1.Subscription, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	name := subscription.Name
+	channel := fmt.Sprintf("%s:%s", subscription.Spec.Channel.Kind, subscription.Spec.Channel.Name)
+	subscriber := destinationToString(subscription.Spec.Subscriber)
+	reply := destinationToString(subscription.Spec.Reply)
+	dls := destinationToString(deadLetterSink(subscription))
+	ready := commands.ReadyCondition(subscription.Status.Conditions)
+	reason := commands.NonReadyConditionReason(subscription.Status.Conditions)
+
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: subscription},
+	}
+
+	if options.AllNamespaces {
+		row.Cells = append(row.Cells, subscription.Namespace)
+	}
+
+	row.Cells = append(row.Cells, name, channel, subscriber, reply, dls, ready, reason)
+	return []metav1beta1.TableRow{row}, nil
+}
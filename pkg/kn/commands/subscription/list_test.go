@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestSubscriptionList(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.SubscriptionsRecorder()
+
+	subscriptionList := &v1beta1.SubscriptionList{Items: []v1beta1.Subscription{
+		*createSubscription("sub0", "pipe", "mysvc", "default", "dlq"),
+		*createSubscription("sub1", "pipe", "othersvc", "", ""),
+	}}
+	cRecorder.ListSubscription(subscriptionList, nil)
+
+	out, err := executeSubscriptionCommand(cClient, dynamicfake.CreateFakeKnDynamicClient("default"), "list")
+	assert.NilError(t, err)
+	lines := strings.Split(out, "\n")
+	assert.Check(t, util.ContainsAll(lines[0], "NAME", "CHANNEL", "SUBSCRIBER", "REPLY", "DEAD LETTER SINK", "READY", "REASON"))
+	assert.Check(t, util.ContainsAll(lines[1], "sub0", "Channel:pipe", "svc:mysvc", "broker:default", "svc:dlq"))
+	assert.Check(t, util.ContainsAll(lines[2], "sub1", "Channel:pipe", "svc:othersvc"))
+
+	cRecorder.Validate()
+}
+
+func TestSubscriptionListEmpty(t *testing.T) {
+	cClient := knmessagingv1beta1.NewMockKnMessagingClient(t)
+	cRecorder := cClient.SubscriptionsRecorder()
+	cRecorder.ListSubscription(&v1beta1.SubscriptionList{}, nil)
+
+	out, err := executeSubscriptionCommand(cClient, dynamicfake.CreateFakeKnDynamicClient("default"), "list")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "No", "subscriptions", "found"))
+
+	cRecorder.Validate()
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subscription
+
+import (
+	"bytes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	eventingv1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/kn/commands"
+	knmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+)
+
+// Helper methods
+var blankConfig clientcmd.ClientConfig
+
+func init() {
+	var err error
+	blankConfig, err = clientcmd.NewClientConfigFromBytes([]byte(`kind: Config
+version: v1
+users:
+- name: u
+clusters:
+- name: c
+  cluster:
+    server: example.com
+contexts:
+- name: x
+  context:
+    user: u
+    cluster: c
+current-context: x
+`))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func executeSubscriptionCommand(messagingClient knmessagingv1beta1.KnMessagingClient, dynamicClient clientdynamic.KnDynamicClient, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewDynamicClient = func(namespace string) (clientdynamic.KnDynamicClient, error) {
+		return dynamicClient, nil
+	}
+	knParams.NewMessagingClient = func(namespace string) (knmessagingv1beta1.KnMessagingClient, error) {
+		return messagingClient, nil
+	}
+
+	cmd := NewSubscriptionCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func createSubscription(name, channel, subscriber, reply, dls string) *v1beta1.Subscription {
+	return knmessagingv1beta1.NewSubscriptionBuilder(name).
+		Namespace("default").
+		Channel(&corev1.ObjectReference{APIVersion: "messaging.knative.dev/v1beta1", Kind: "Channel", Name: channel}).
+		Subscriber(createServiceSink(subscriber)).
+		Reply(createBrokerSink(reply)).
+		DeadLetterSink(createServiceSink(dls)).
+		Build()
+}
+
+func createServiceSink(name string) *duckv1.Destination {
+	if name == "" {
+		return nil
+	}
+	return &duckv1.Destination{
+		Ref: &duckv1.KReference{Kind: "Service", Name: name, APIVersion: "serving.knative.dev/v1", Namespace: "default"},
+	}
+}
+
+func createBrokerSink(name string) *duckv1.Destination {
+	if name == "" {
+		return nil
+	}
+	return &duckv1.Destination{
+		Ref: &duckv1.KReference{Kind: "Broker", Name: name, APIVersion: "eventing.knative.dev/v1beta1", Namespace: "default"},
+	}
+}
+
+func createServiceObject(name string) *servingv1.Service {
+	return &servingv1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+	}
+}
+
+func createBrokerObject(name string) *eventingv1beta1.Broker {
+	return &eventingv1beta1.Broker{
+		TypeMeta:   metav1.TypeMeta{Kind: "Broker", APIVersion: "eventing.knative.dev/v1beta1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+	}
+}
@@ -20,31 +20,43 @@ import (
 	"os"
 	"path/filepath"
 
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/transport"
 	eventingv1beta1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/eventing/v1beta1"
+	messagingv1beta1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/messaging/v1beta1"
 	sourcesv1alpha2client "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2"
 	servingv1client "knative.dev/serving/pkg/client/clientset/versioned/typed/serving/v1"
 
+	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/sources/v1alpha2"
 	"knative.dev/client/pkg/util"
 
 	clientdynamic "knative.dev/client/pkg/dynamic"
 	knerrors "knative.dev/client/pkg/errors"
 	clienteventingv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
+	clientmessagingv1beta1 "knative.dev/client/pkg/messaging/v1beta1"
+	"knative.dev/client/pkg/negotiation"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	clientservingv1alpha1 "knative.dev/client/pkg/serving/v1alpha1"
 )
 
 // KnParams for creating commands. Useful for inserting mocks for testing.
 type KnParams struct {
-	Output            io.Writer
-	KubeCfgPath       string
-	ClientConfig      clientcmd.ClientConfig
-	NewServingClient  func(namespace string) (clientservingv1.KnServingClient, error)
-	NewSourcesClient  func(namespace string) (v1alpha2.KnSourcesClient, error)
-	NewEventingClient func(namespace string) (clienteventingv1beta1.KnEventingClient, error)
-	NewDynamicClient  func(namespace string) (clientdynamic.KnDynamicClient, error)
+	Output             io.Writer
+	KubeCfgPath        string
+	ClientConfig       clientcmd.ClientConfig
+	NewServingClient   func(namespace string) (clientservingv1.KnServingClient, error)
+	NewSourcesClient   func(namespace string) (v1alpha2.KnSourcesClient, error)
+	NewEventingClient  func(namespace string) (clienteventingv1beta1.KnEventingClient, error)
+	NewDynamicClient   func(namespace string) (clientdynamic.KnDynamicClient, error)
+	NewMessagingClient func(namespace string) (clientmessagingv1beta1.KnMessagingClient, error)
+
+	NewServingV1alpha1Client func(namespace string) (clientservingv1alpha1.KnServingClient, error)
+	NewKubeClient            func() (kubernetes.Interface, error)
 
 	// General global options
 	LogHTTP bool
@@ -69,6 +81,18 @@ func (params *KnParams) Initialize() {
 	if params.NewDynamicClient == nil {
 		params.NewDynamicClient = params.newDynamicClient
 	}
+
+	if params.NewMessagingClient == nil {
+		params.NewMessagingClient = params.newMessagingClient
+	}
+
+	if params.NewServingV1alpha1Client == nil {
+		params.NewServingV1alpha1Client = params.newServingV1alpha1Client
+	}
+
+	if params.NewKubeClient == nil {
+		params.NewKubeClient = params.newKubeClient
+	}
 }
 
 func (params *KnParams) newServingClient(namespace string) (clientservingv1.KnServingClient, error) {
@@ -82,7 +106,7 @@ func (params *KnParams) newServingClient(namespace string) (clientservingv1.KnSe
 }
 
 func (params *KnParams) newSourcesClient(namespace string) (v1alpha2.KnSourcesClient, error) {
-	restConfig, err := params.RestConfig()
+	restConfig, err := params.negotiatingRestConfig()
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +116,7 @@ func (params *KnParams) newSourcesClient(namespace string) (v1alpha2.KnSourcesCl
 }
 
 func (params *KnParams) newEventingClient(namespace string) (clienteventingv1beta1.KnEventingClient, error) {
-	restConfig, err := params.RestConfig()
+	restConfig, err := params.negotiatingRestConfig()
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +135,56 @@ func (params *KnParams) newDynamicClient(namespace string) (clientdynamic.KnDyna
 	return clientdynamic.NewKnDynamicClient(client, namespace), nil
 }
 
+func (params *KnParams) newMessagingClient(namespace string) (clientmessagingv1beta1.KnMessagingClient, error) {
+	restConfig, err := params.negotiatingRestConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, _ := messagingv1beta1.NewForConfig(restConfig)
+	return clientmessagingv1beta1.NewKnMessagingClient(client, namespace), nil
+}
+
+func (params *KnParams) newServingV1alpha1Client(namespace string) (clientservingv1alpha1.KnServingClient, error) {
+	restConfig, err := params.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, _ := dynamic.NewForConfig(restConfig)
+	return clientservingv1alpha1.NewKnServingClient(client, namespace), nil
+}
+
+func (params *KnParams) newKubeClient() (kubernetes.Interface, error) {
+	restConfig, err := params.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(restConfig)
+}
+
+// negotiatingRestConfig returns a REST config whose requests are sent to the
+// API versions negotiated with the cluster, so that typed clients keep working
+// when the cluster serves only other versions than kn has been built against
+func (params *KnParams) negotiatingRestConfig() (*rest.Config, error) {
+	restConfig, err := params.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	negotiationWrapper := negotiation.NewTransportWrapper(negotiation.NewNegotiator(discoveryClient))
+	if restConfig.WrapTransport != nil {
+		restConfig.WrapTransport = transport.Wrappers(restConfig.WrapTransport, negotiationWrapper)
+	} else {
+		restConfig.WrapTransport = negotiationWrapper
+	}
+	return restConfig, nil
+}
+
 // RestConfig returns REST config, which can be to use to create specific clientset
 func (params *KnParams) RestConfig() (*rest.Config, error) {
 	var err error
@@ -139,13 +213,13 @@ func (params *KnParams) RestConfig() (*rest.Config, error) {
 func (params *KnParams) GetClientConfig() (clientcmd.ClientConfig, error) {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	if len(params.KubeCfgPath) == 0 {
-		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}), nil
+		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides()), nil
 	}
 
 	_, err := os.Stat(params.KubeCfgPath)
 	if err == nil {
 		loadingRules.ExplicitPath = params.KubeCfgPath
-		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}), nil
+		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides()), nil
 	}
 
 	if !os.IsNotExist(err) {
@@ -159,3 +233,35 @@ func (params *KnParams) GetClientConfig() (clientcmd.ClientConfig, error) {
 	}
 	return nil, fmt.Errorf("Config file '%s' can not be found", params.KubeCfgPath)
 }
+
+// CurrentContext returns the name of the kubeconfig context in use, which is
+// the context of the selected profile or the kubeconfig's current context
+func (params *KnParams) CurrentContext() (string, error) {
+	if profile := config.GlobalConfig.Profile(); profile != nil && profile.Context != "" {
+		return profile.Context, nil
+	}
+	var err error
+	if params.ClientConfig == nil {
+		params.ClientConfig, err = params.GetClientConfig()
+		if err != nil {
+			return "", err
+		}
+	}
+	rawConfig, err := params.ClientConfig.RawConfig()
+	if err != nil {
+		return "", err
+	}
+	return rawConfig.CurrentContext, nil
+}
+
+// configOverrides returns the kubeconfig context and namespace configured in
+// the selected profile
+func configOverrides() *clientcmd.ConfigOverrides {
+	overrides := &clientcmd.ConfigOverrides{}
+	profile := config.GlobalConfig.Profile()
+	if profile != nil {
+		overrides.CurrentContext = profile.Context
+		overrides.Context.Namespace = profile.Namespace
+	}
+	return overrides
+}
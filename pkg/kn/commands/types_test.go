@@ -16,6 +16,7 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
@@ -23,6 +24,7 @@ import (
 	"gotest.tools/assert"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/util"
 )
 
@@ -142,6 +144,58 @@ func TestGetClientConfig(t *testing.T) {
 	}
 }
 
+func TestGetClientConfigWithProfile(t *testing.T) {
+	kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: a
+  cluster:
+    server: https://127.0.0.1:8080
+- name: b
+  cluster:
+    server: https://127.0.0.2:8080
+contexts:
+- name: a
+  context:
+    cluster: a
+    namespace: ns-a
+- name: b
+  context:
+    cluster: b
+    namespace: ns-b
+current-context: a
+`
+	tmpFile, err := ioutil.TempFile("", "kubeconfig")
+	assert.NilError(t, err)
+	defer os.Remove(tmpFile.Name())
+	_, err = tmpFile.WriteString(kubeconfig)
+	assert.NilError(t, err)
+	tmpFile.Close()
+
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	for _, tc := range []struct {
+		profile           *config.Profile
+		expectedNamespace string
+		expectedHost      string
+	}{
+		{nil, "ns-a", "https://127.0.0.1:8080"},
+		{&config.Profile{Context: "b"}, "ns-b", "https://127.0.0.2:8080"},
+		{&config.Profile{Context: "b", Namespace: "team"}, "team", "https://127.0.0.2:8080"},
+		{&config.Profile{Namespace: "team"}, "team", "https://127.0.0.1:8080"},
+	} {
+		config.GlobalConfig = &config.TestConfig{TestProfile: tc.profile}
+		p := &KnParams{KubeCfgPath: tmpFile.Name()}
+		namespace, err := p.CurrentNamespace()
+		assert.NilError(t, err)
+		assert.Equal(t, namespace, tc.expectedNamespace)
+		restConfig, err := p.ClientConfig.ClientConfig()
+		assert.NilError(t, err)
+		assert.Equal(t, restConfig.Host, tc.expectedHost)
+	}
+}
+
 func TestNewSourcesClient(t *testing.T) {
 	basic, err := clientcmd.NewClientConfigFromBytes([]byte(BASIC_KUBECONFIG))
 	namespace := "test"
@@ -0,0 +1,128 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package version
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+const (
+	servingNamespace     = "knative-serving"
+	servingReleaseLabel  = "serving.knative.dev/release"
+	eventingNamespace    = "knative-eventing"
+	eventingReleaseLabel = "eventing.knative.dev/release"
+
+	versionNotInstalled = "not installed"
+	versionUnknown      = "unknown"
+)
+
+// serverTimeout is the maximum time to wait for the cluster to answer
+var serverTimeout = 5 * time.Second
+
+// serverVersion describes the Knative installation of the cluster
+type serverVersion struct {
+	ServingVersion  string
+	EventingVersion string
+	// All Knative API group versions served by the cluster
+	ServedAPIs []string
+	// API group versions required by kn but not served by the cluster
+	MissingAPIs []string
+	// Set when the cluster could not be queried
+	Error string `json:",omitempty"`
+}
+
+// getServerVersion queries the cluster for the installed Knative versions
+func getServerVersion(p *commands.KnParams) *serverVersion {
+	done := make(chan *serverVersion, 1)
+	go func() {
+		done <- discoverServerVersion(p)
+	}()
+	select {
+	case v := <-done:
+		return v
+	case <-time.After(serverTimeout):
+		return &serverVersion{Error: fmt.Sprintf("no answer from the cluster within %s", serverTimeout)}
+	}
+}
+
+func discoverServerVersion(p *commands.KnParams) *serverVersion {
+	client, err := p.NewKubeClient()
+	if err != nil {
+		return &serverVersion{Error: err.Error()}
+	}
+	groups, err := client.Discovery().ServerGroups()
+	if err != nil {
+		return &serverVersion{Error: err.Error()}
+	}
+
+	v := &serverVersion{}
+	served := map[string]bool{}
+	installed := map[string]bool{}
+	for _, group := range groups.Groups {
+		if !strings.HasSuffix(group.Name, ".knative.dev") {
+			continue
+		}
+		installed[group.Name] = true
+		for _, version := range group.Versions {
+			v.ServedAPIs = append(v.ServedAPIs, version.GroupVersion)
+			served[version.GroupVersion] = true
+		}
+	}
+	sort.Strings(v.ServedAPIs)
+	for _, api := range requiredAPIs() {
+		if !served[api] {
+			v.MissingAPIs = append(v.MissingAPIs, api)
+		}
+	}
+	v.ServingVersion = releaseVersion(client, installed["serving.knative.dev"], servingNamespace, servingReleaseLabel)
+	v.EventingVersion = releaseVersion(client, installed["eventing.knative.dev"], eventingNamespace, eventingReleaseLabel)
+	return v
+}
+
+// releaseVersion returns the version of a Knative component, which is taken
+// from the release label of the component's namespace
+func releaseVersion(client kubernetes.Interface, installed bool, namespace string, label string) string {
+	if !installed {
+		return versionNotInstalled
+	}
+	ns, err := client.CoreV1().Namespaces().Get(namespace, metav1.GetOptions{})
+	if err != nil || ns.Labels[label] == "" {
+		return versionUnknown
+	}
+	return ns.Labels[label]
+}
+
+// requiredAPIs returns the API group versions kn is using
+func requiredAPIs() []string {
+	var apis []string
+	for _, component := range []string{"serving", "eventing"} {
+		for _, api := range apiVersions[component] {
+			gv, err := schema.ParseGroupVersion(strings.Fields(api)[0])
+			if err == nil {
+				apis = append(apis, gv.String())
+			}
+		}
+	}
+	return apis
+}
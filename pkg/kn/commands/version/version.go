@@ -17,6 +17,8 @@ package version
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/yaml"
@@ -35,7 +37,8 @@ var apiVersions = map[string][]string{
 	},
 	"eventing": {
 		"sources.knative.dev/v1alpha2 (knative-eventing v0.15.0)",
-		"eventing.knative.dev/v1alpha1 (knative-eventing v0.15.0)",
+		"eventing.knative.dev/v1beta1 (knative-eventing v0.15.0)",
+		"messaging.knative.dev/v1beta1 (knative-eventing v0.15.0)",
 	},
 }
 
@@ -44,16 +47,22 @@ type knVersion struct {
 	BuildDate     string
 	GitRevision   string
 	SupportedAPIs map[string][]string
+	Server        *serverVersion `json:",omitempty"`
 }
 
 // NewVersionCommand implements 'kn version' command
 func NewVersionCommand(p *commands.KnParams) *cobra.Command {
+	var clientOnly bool
 	versionCmd := &cobra.Command{
 		Use:   "version",
-		Short: "Show the version of this client",
+		Short: "Show the version of this client and of Knative in the cluster",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			var server *serverVersion
+			if !clientOnly {
+				server = getServerVersion(p)
+			}
 			if cmd.Flags().Changed("output") {
-				return printVersionMachineReadable(cmd)
+				return printVersionMachineReadable(cmd, server)
 			}
 			out := cmd.OutOrStdout()
 			fmt.Fprintf(out, "Version:      %s\n", Version)
@@ -68,9 +77,13 @@ func NewVersionCommand(p *commands.KnParams) *cobra.Command {
 			for _, api := range apiVersions["eventing"] {
 				fmt.Fprintf(out, "  - %s\n", api)
 			}
+			if server != nil {
+				printServerVersion(out, server)
+			}
 			return nil
 		},
 	}
+	versionCmd.Flags().BoolVar(&clientOnly, "client", false, "Show the client version only and don't contact the cluster.")
 	versionCmd.Flags().StringP(
 		"output",
 		"o",
@@ -80,9 +93,26 @@ func NewVersionCommand(p *commands.KnParams) *cobra.Command {
 	return versionCmd
 }
 
-func printVersionMachineReadable(cmd *cobra.Command) error {
+func printServerVersion(out io.Writer, server *serverVersion) {
+	fmt.Fprintf(out, "Server:\n")
+	if server.Error != "" {
+		fmt.Fprintf(out, "  Error: %s\n", server.Error)
+		return
+	}
+	fmt.Fprintf(out, "* Serving:  %s\n", server.ServingVersion)
+	fmt.Fprintf(out, "* Eventing: %s\n", server.EventingVersion)
+	fmt.Fprintf(out, "* Served APIs:\n")
+	for _, api := range server.ServedAPIs {
+		fmt.Fprintf(out, "  - %s\n", api)
+	}
+	if len(server.MissingAPIs) > 0 {
+		fmt.Fprintf(out, "\nWARNING: The cluster doesn't serve the following APIs used by this client: %s\n", strings.Join(server.MissingAPIs, ", "))
+	}
+}
+
+func printVersionMachineReadable(cmd *cobra.Command, server *serverVersion) error {
 	out := cmd.OutOrStdout()
-	v := knVersion{Version, BuildDate, GitRevision, apiVersions}
+	v := knVersion{Version, BuildDate, GitRevision, apiVersions, server}
 	format := cmd.Flag("output").Value.String()
 	switch format {
 	case "JSON", "json":
@@ -17,11 +17,18 @@ package version
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"sort"
 	"testing"
 	"text/template"
 
 	"github.com/spf13/cobra"
 	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/yaml"
 
 	"knative.dev/client/pkg/kn/commands"
@@ -36,6 +43,11 @@ Supported APIs:
   - {{$apis}}{{end}}
 * Eventing{{range $apis := .SupportedAPIs.eventing }}
   - {{$apis}}{{end}}
+Server:
+* Serving:  {{.Server.ServingVersion}}
+* Eventing: {{.Server.EventingVersion}}
+* Served APIs:{{range $apis := .Server.ServedAPIs }}
+  - {{$apis}}{{end}}
 `
 
 const (
@@ -57,9 +69,14 @@ func TestVersion(t *testing.T) {
 		Version = fakeVersion
 		BuildDate = fakeBuildDate
 		GitRevision = fakeGitRevision
-		knVersionObj = knVersion{fakeVersion, fakeBuildDate, fakeGitRevision, apiVersions}
+		knVersionObj = knVersion{fakeVersion, fakeBuildDate, fakeGitRevision, apiVersions, &serverVersion{
+			ServingVersion:  "v0.15.0",
+			EventingVersion: versionUnknown,
+			ServedAPIs:      requiredAPIs(),
+		}}
+		sort.Strings(knVersionObj.Server.ServedAPIs)
 		expectedOutput = genVersionOuput(t, knVersionObj)
-		knParams = &commands.KnParams{}
+		knParams = &commands.KnParams{NewKubeClient: newFakeKubeClient(requiredAPIs()...)}
 		versionCmd = NewVersionCommand(knParams)
 		output = new(bytes.Buffer)
 		versionCmd.SetOutput(output)
@@ -105,6 +122,19 @@ func TestVersion(t *testing.T) {
 			assert.DeepEqual(t, in, knVersionObj)
 		})
 
+		t.Run("json with missing APIs", func(t *testing.T) {
+			setup()
+			knParams.NewKubeClient = newFakeKubeClient("serving.knative.dev/v1", "messaging.knative.dev/v1alpha1")
+			versionCmd.SetArgs([]string{"-ojson"})
+			assert.NilError(t, versionCmd.Execute())
+			in := knVersion{}
+			err := json.Unmarshal(output.Bytes(), &in)
+			assert.NilError(t, err)
+			assert.Equal(t, in.Server.EventingVersion, versionNotInstalled)
+			assert.DeepEqual(t, in.Server.ServedAPIs, []string{"messaging.knative.dev/v1alpha1", "serving.knative.dev/v1"})
+			assert.DeepEqual(t, in.Server.MissingAPIs, []string{"sources.knative.dev/v1alpha2", "eventing.knative.dev/v1beta1", "messaging.knative.dev/v1beta1"})
+		})
+
 		t.Run("invalid format", func(t *testing.T) {
 			err := runVersionCmd([]string{"-o", "jsonpath"})
 			assert.Assert(t, err != nil)
@@ -112,6 +142,48 @@ func TestVersion(t *testing.T) {
 		})
 	})
 
+	t.Run("warns about missing APIs", func(t *testing.T) {
+		setup()
+		knParams.NewKubeClient = newFakeKubeClient("serving.knative.dev/v1")
+		assert.NilError(t, versionCmd.Execute())
+		assert.Assert(t, util.ContainsAll(output.String(), "WARNING", "sources.knative.dev/v1alpha2", "eventing.knative.dev/v1beta1"))
+	})
+
+	t.Run("reports unreachable cluster", func(t *testing.T) {
+		setup()
+		knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+			return nil, errors.New("no configuration found")
+		}
+		assert.NilError(t, versionCmd.Execute())
+		assert.Assert(t, util.ContainsAll(output.String(), "Server:", "Error: no configuration found"))
+	})
+
+	t.Run("client version only", func(t *testing.T) {
+		setup()
+		knParams.NewKubeClient = nil
+		versionCmd.SetArgs([]string{"--client", "-ojson"})
+		assert.NilError(t, versionCmd.Execute())
+		in := knVersion{}
+		err := json.Unmarshal(output.Bytes(), &in)
+		assert.NilError(t, err)
+		assert.Assert(t, in.Server == nil)
+	})
+}
+
+func newFakeKubeClient(apis ...string) func() (kubernetes.Interface, error) {
+	return func() (kubernetes.Interface, error) {
+		client := kubefake.NewSimpleClientset(&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   servingNamespace,
+				Labels: map[string]string{servingReleaseLabel: "v0.15.0"},
+			},
+		})
+		fakeDiscovery := client.Discovery().(*fakediscovery.FakeDiscovery)
+		for _, api := range append(apis, "apps/v1") {
+			fakeDiscovery.Resources = append(fakeDiscovery.Resources, &metav1.APIResourceList{GroupVersion: api})
+		}
+		return client, nil
+	}
 }
 
 func genVersionOuput(t *testing.T, obj knVersion) string {
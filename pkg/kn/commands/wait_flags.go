@@ -19,6 +19,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"knative.dev/client/pkg/kn/config"
 	knflags "knative.dev/client/pkg/kn/flags"
 )
 
@@ -52,5 +53,8 @@ func (p *WaitFlags) AddConditionWaitFlags(command *cobra.Command, waitTimeoutDef
 	command.Flags().BoolVar(&p.Async, "async", !waitDefault, "DEPRECATED: please use --no-wait instead. "+knflags.InvertUsage(waitUsage))
 	knflags.AddBothBoolFlagsUnhidden(command.Flags(), &p.Wait, "wait", "", waitDefault, waitUsage)
 	timeoutUsage := fmt.Sprintf("Seconds to wait before giving up on waiting for %s to be %s.", what, until)
+	if profile := config.GlobalConfig.Profile(); profile != nil && profile.WaitTimeout != nil {
+		waitTimeoutDefault = *profile.WaitTimeout
+	}
 	command.Flags().IntVar(&p.TimeoutInSeconds, "wait-timeout", waitTimeoutDefault, timeoutUsage)
 }
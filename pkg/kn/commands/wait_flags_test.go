@@ -19,6 +19,7 @@ import (
 	"strings"
 	"testing"
 
+	"knative.dev/client/pkg/kn/config"
 	knflags "knative.dev/client/pkg/kn/flags"
 
 	"github.com/spf13/cobra"
@@ -109,6 +110,24 @@ func TestAddWaitForReadyFlags(t *testing.T) {
 	}
 }
 
+func TestAddWaitForReadyFlagsWithProfile(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	defer func() { config.GlobalConfig = oldConfig }()
+	timeout := 30
+	config.GlobalConfig = &config.TestConfig{TestProfile: &config.Profile{WaitTimeout: &timeout}}
+
+	flags := &WaitFlags{}
+	cmd := cobra.Command{}
+	flags.AddConditionWaitFlags(&cmd, 60, "create", "service", "ready")
+	err := cmd.ParseFlags([]string{})
+	assert.NilError(t, err)
+	assert.Equal(t, flags.TimeoutInSeconds, 30)
+
+	err = cmd.ParseFlags([]string{"--wait-timeout=120"})
+	assert.NilError(t, err)
+	assert.Equal(t, flags.TimeoutInSeconds, 120)
+}
+
 func TestAddWaitUsageMessage(t *testing.T) {
 
 	flags := &WaitFlags{}
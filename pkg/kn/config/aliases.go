@@ -0,0 +1,104 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// parse the aliases and default flags and store them in the global configuration
+func parseAliases() error {
+	aliases := map[string][]string{}
+	for name, expansion := range viper.GetStringMapString(keyAliases) {
+		if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t") {
+			return errors.Errorf("invalid alias name '%s' in configuration file %s", name, viper.ConfigFileUsed())
+		}
+		args, err := splitArgs(expansion)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error while parsing alias '%s' in configuration file %s", name, viper.ConfigFileUsed()))
+		}
+		if len(args) == 0 {
+			return errors.Errorf("alias '%s' in configuration file %s must not be empty", name, viper.ConfigFileUsed())
+		}
+		aliases[name] = args
+	}
+	globalConfig.aliases = aliases
+
+	defaultFlags := map[string][]string{}
+	for command, flags := range viper.GetStringMapString(keyDefaultFlags) {
+		args, err := splitArgs(flags)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error while parsing default flags for '%s' in configuration file %s", command, viper.ConfigFileUsed()))
+		}
+		defaultFlags[strings.Join(strings.Fields(command), " ")] = args
+	}
+	globalConfig.defaultFlags = defaultFlags
+	return nil
+}
+
+// splitArgs splits a command line into its arguments. Arguments are separated by
+// whitespace, single and double quotes group an argument and a backslash escapes
+// the next character outside of single quotes.
+func splitArgs(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, c := range line {
+		switch {
+		case escaped:
+			current.WriteRune(c)
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				current.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(c)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.Errorf("unterminated %c quote in '%s'", quote, line)
+	}
+	if escaped {
+		return nil, errors.Errorf("trailing backslash in '%s'", line)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
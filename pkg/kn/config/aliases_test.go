@@ -0,0 +1,83 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestBootstrapConfigWithAliases(t *testing.T) {
+	configYaml := `
+aliases:
+  deploy: service update --no-wait
+  hello: "service create hello --image 'gcr.io/knative-samples/helloworld-go' --env TARGET=\"kn user\""
+default-flags:
+  service create: --no-lock-to-digest --label team=x
+  service  update: --no-wait
+`
+	_, cleanup := setupConfig(t, configYaml)
+	defer cleanup()
+
+	err := BootstrapConfig()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, GlobalConfig.Aliases(), map[string][]string{
+		"deploy": {"service", "update", "--no-wait"},
+		"hello":  {"service", "create", "hello", "--image", "gcr.io/knative-samples/helloworld-go", "--env", "TARGET=kn user"},
+	})
+	assert.DeepEqual(t, GlobalConfig.DefaultFlags(), map[string][]string{
+		"service create": {"--no-lock-to-digest", "--label", "team=x"},
+		"service update": {"--no-wait"},
+	})
+}
+
+func TestBootstrapConfigWithInvalidAliases(t *testing.T) {
+	for _, d := range []struct {
+		configYaml string
+		expected   string
+	}{
+		{"aliases:\n  deploy: \"service 'update\"\n", "unterminated ' quote"},
+		{"aliases:\n  deploy: \"\"\n", "alias 'deploy'"},
+		{"aliases:\n  --deploy: service\n", "invalid alias name '--deploy'"},
+		{"default-flags:\n  service create: \"--env 'a=b\"\n", "default flags for 'service create'"},
+	} {
+		_, cleanup := setupConfig(t, d.configYaml)
+		err := BootstrapConfig()
+		cleanup()
+		assert.ErrorContains(t, err, d.expected)
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	for _, d := range []struct {
+		line     string
+		expected []string
+	}{
+		{"", nil},
+		{"  service   list ", []string{"service", "list"}},
+		{`--env "A=b c" --label 'x="y"'`, []string{"--env", "A=b c", "--label", `x="y"`}},
+		{`a\ b "" c`, []string{"a b", "", "c"}},
+		{`'a\b'`, []string{`a\b`}},
+	} {
+		args, err := splitArgs(d.line)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, args, d.expected)
+	}
+
+	_, err := splitArgs(`trailing\`)
+	assert.ErrorContains(t, err, "trailing backslash")
+}
@@ -43,6 +43,18 @@ type config struct {
 
 	// sinkMappings is a list of sink mapping
 	sinkMappings []SinkMapping
+
+	// profileName is the selected profile
+	profileName string
+
+	// profile is the selected profile, nil if none is selected
+	profile *Profile
+
+	// aliases maps alias names to their expansion
+	aliases map[string][]string
+
+	// defaultFlags maps command paths to flags added to each invocation
+	defaultFlags map[string][]string
 }
 
 // ConfigFile returns the config file which is either the default XDG conform
@@ -80,8 +92,43 @@ func (c *config) LookupPluginsInPath() bool {
 	}
 }
 
+// PluginIndex returns the location of the plugin index
+func (c *config) PluginIndex() string {
+	return viper.GetString(keyPluginsIndex)
+}
+
+// PluginConfig returns the configuration of the plugin with the given name
+func (c *config) PluginConfig(name string) map[string]interface{} {
+	return viper.GetStringMap(keyPluginsPrefix + name)
+}
+
+// SinkMappings returns the global sink mappings followed by the
+// ones of the selected profile
 func (c *config) SinkMappings() []SinkMapping {
-	return c.sinkMappings
+	if c.profile == nil || len(c.profile.SinkMappings) == 0 {
+		return c.sinkMappings
+	}
+	return append(append([]SinkMapping{}, c.sinkMappings...), c.profile.SinkMappings...)
+}
+
+// Aliases returns the command aliases defined in the configuration
+func (c *config) Aliases() map[string][]string {
+	return c.aliases
+}
+
+// DefaultFlags returns the default flags per command path
+func (c *config) DefaultFlags() map[string][]string {
+	return c.defaultFlags
+}
+
+// ProfileName returns the name of the selected profile
+func (c *config) ProfileName() string {
+	return c.profileName
+}
+
+// Profile returns the selected profile
+func (c *config) Profile() *Profile {
+	return c.profile
 }
 
 // Config used for flag binding
@@ -115,12 +162,24 @@ func BootstrapConfig() error {
 		return err
 	}
 
+	// A profile given on the command line has precedence over the environment
+	profileName := bootstrapFlagSet.Lookup(flagProfile).Value.String()
+	if profileName == "" {
+		profileName = os.Getenv(envProfile)
+	}
+	explicitProfile := profileName != ""
+	// 'kn config' must always work, as it is the way to fix an unknown profile
+	configCommand := isConfigCommand(bootstrapFlagSet.Args())
+
 	// Check if configfile exists. If not, just return
 	configFile := GlobalConfig.ConfigFile()
 	_, err = os.Lstat(configFile)
 	if err != nil {
 		if os.IsNotExist(err) {
 			// No config file to read
+			if profileName != "" && !configCommand {
+				return errors.Errorf("profile '%s' not found as configuration file %s doesn't exist", profileName, configFile)
+			}
 			return nil
 		}
 		return errors.Wrap(err, fmt.Sprintf("cannot stat configfile %s", configFile))
@@ -143,7 +202,35 @@ func BootstrapConfig() error {
 
 	// Deserialize sink mappings if configured
 	err = parseSinkMappings()
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Deserialize aliases and default flags
+	err = parseAliases()
+	if err != nil {
+		return err
+	}
+
+	// Deserialize the selected profile. Only a profile selected explicitly
+	// must exist, a stale current-profile is ignored.
+	if profileName == "" {
+		profileName = viper.GetString(keyCurrentProfile)
+	}
+	if profileName != "" && !viper.IsSet(keyProfiles+"."+profileName) {
+		if explicitProfile && !configCommand {
+			return errors.Errorf("profile '%s' not found in configuration file %s", profileName, viper.ConfigFileUsed())
+		}
+		fmt.Fprintf(os.Stderr, "WARNING: profile '%s' not found in configuration file %s, continuing without a profile\n", profileName, viper.ConfigFileUsed())
+		return nil
+	}
+	return parseProfile(profileName)
+}
+
+// isConfigCommand returns true if the given arguments (including the binary
+// name) run a 'kn config' command
+func isConfigCommand(args []string) bool {
+	return len(args) > 1 && args[1] == "config"
 }
 
 // Add bootstrap flags use in a separate bootstrap proceeds
@@ -151,6 +238,7 @@ func AddBootstrapFlags(flags *flag.FlagSet) {
 	flags.StringVar(&globalConfig.configFile, "config", "", fmt.Sprintf("kn configuration file (default: %s)", defaultConfigFileForUsageMessage()))
 	flags.String(flagPluginsDir, "", "Directory holding kn plugins")
 	flags.Bool(flagPluginsLookupInPath, false, "Search kn plugins also in $PATH")
+	flags.String(flagProfile, "", fmt.Sprintf("kn configuration profile to use (default: $%s or current-profile from the configuration file)", envProfile))
 
 	// Let's try that and mark the flags as hidden: (as those configuration is a permanent choice of operation)
 	flags.MarkHidden(flagPluginsLookupInPath)
@@ -243,6 +331,23 @@ func parseSinkMappings() error {
 	return nil
 }
 
+// parse the profile with the given name and store it in the global configuration
+func parseProfile(name string) error {
+	if name == "" {
+		return nil
+	}
+	key := keyProfiles + "." + name
+	profile := &Profile{}
+	err := viper.UnmarshalKey(key, profile)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("error while parsing profile '%s' in configuration file %s",
+			name, viper.ConfigFileUsed()))
+	}
+	globalConfig.profileName = name
+	globalConfig.profile = profile
+	return nil
+}
+
 // Prepare the default config file for the usage message
 func defaultConfigFileForUsageMessage() string {
 	if runtime.GOOS == "windows" {
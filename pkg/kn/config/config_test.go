@@ -30,6 +30,11 @@ func TestBootstrapConfig(t *testing.T) {
 plugins:
   directory: /tmp
   path-lookup: true
+  index: https://example.com/plugins.yaml
+  source-kafka:
+    bootstrap: my-cluster:9092
+    topics:
+      default: events
 
 eventing:
   sink-mappings:
@@ -48,6 +53,11 @@ eventing:
 	assert.Equal(t, GlobalConfig.ConfigFile(), configFile)
 	assert.Equal(t, GlobalConfig.PluginsDir(), "/tmp")
 	assert.Equal(t, GlobalConfig.LookupPluginsInPath(), true)
+	assert.Equal(t, GlobalConfig.PluginIndex(), "https://example.com/plugins.yaml")
+	kafkaConfig := GlobalConfig.PluginConfig("source-kafka")
+	assert.Equal(t, kafkaConfig["bootstrap"], "my-cluster:9092")
+	assert.DeepEqual(t, kafkaConfig["topics"], map[string]interface{}{"default": "events"})
+	assert.Equal(t, len(GlobalConfig.PluginConfig("bogus")), 0)
 	assert.Equal(t, len(GlobalConfig.SinkMappings()), 1)
 	assert.DeepEqual(t, (GlobalConfig.SinkMappings())[0], SinkMapping{
 		Prefix:   "service",
@@ -99,6 +109,126 @@ sink:
 	})
 }
 
+func TestBootstrapConfigWithProfile(t *testing.T) {
+	configYaml := `
+current-profile: staging
+eventing:
+  sink-mappings:
+  - prefix: service
+    resource: services
+    group: core
+    version: v1
+profiles:
+  staging:
+    context: staging-cluster
+    namespace: team-a
+    wait-timeout: 120
+    revision-name: "{{.Service}}-{{.Generation}}"
+    lock-to-digest: false
+    sink-mappings:
+    - prefix: svc
+      resource: services
+      group: serving.knative.dev
+      version: v1
+  prod:
+    context: prod-cluster
+`
+
+	_, cleanup := setupConfig(t, configYaml)
+	defer cleanup()
+
+	err := BootstrapConfig()
+	assert.NilError(t, err)
+
+	assert.Equal(t, GlobalConfig.ProfileName(), "staging")
+	profile := GlobalConfig.Profile()
+	assert.Assert(t, profile != nil)
+	assert.Equal(t, profile.Context, "staging-cluster")
+	assert.Equal(t, profile.Namespace, "team-a")
+	assert.Equal(t, *profile.WaitTimeout, 120)
+	assert.Equal(t, *profile.RevisionName, "{{.Service}}-{{.Generation}}")
+	assert.Equal(t, *profile.LockToDigest, false)
+	mappings := GlobalConfig.SinkMappings()
+	assert.Equal(t, len(mappings), 2)
+	assert.Equal(t, mappings[0].Prefix, "service")
+	assert.DeepEqual(t, mappings[1], SinkMapping{
+		Prefix:   "svc",
+		Resource: "services",
+		Group:    "serving.knative.dev",
+		Version:  "v1",
+	})
+
+	// Environment has precedence over the current profile
+	_, cleanup = setupConfig(t, configYaml)
+	defer cleanup()
+	os.Setenv("KN_PROFILE", "prod")
+	defer os.Unsetenv("KN_PROFILE")
+	err = BootstrapConfig()
+	assert.NilError(t, err)
+	assert.Equal(t, GlobalConfig.ProfileName(), "prod")
+	assert.Equal(t, GlobalConfig.Profile().Context, "prod-cluster")
+	assert.Assert(t, GlobalConfig.Profile().WaitTimeout == nil)
+	assert.Equal(t, len(GlobalConfig.SinkMappings()), 1)
+
+	// Flag has precedence over the environment
+	_, cleanup = setupConfig(t, configYaml)
+	defer cleanup()
+	os.Args = append(os.Args, "--profile", "staging")
+	err = BootstrapConfig()
+	assert.NilError(t, err)
+	assert.Equal(t, GlobalConfig.ProfileName(), "staging")
+}
+
+func TestBootstrapConfigWithUnknownProfile(t *testing.T) {
+	_, cleanup := setupConfig(t, "profiles:\n  staging: {}\n")
+	defer cleanup()
+	os.Args = append(os.Args, "--profile", "bogus")
+	err := BootstrapConfig()
+	assert.ErrorContains(t, err, "profile 'bogus' not found")
+
+	_, cleanup = setupConfig(t, "")
+	defer cleanup()
+	os.Args = []string{"kn", "--profile", "bogus"}
+	err = BootstrapConfig()
+	assert.ErrorContains(t, err, "profile 'bogus' not found")
+	assert.ErrorContains(t, err, "doesn't exist")
+}
+
+func TestBootstrapConfigWithStaleCurrentProfile(t *testing.T) {
+	_, cleanup := setupConfig(t, "current-profile: gone\nprofiles:\n  staging: {}\n")
+	defer cleanup()
+	err := BootstrapConfig()
+	assert.NilError(t, err)
+	assert.Equal(t, GlobalConfig.ProfileName(), "")
+	assert.Assert(t, GlobalConfig.Profile() == nil)
+}
+
+func TestBootstrapConfigUnknownProfileForConfigCommand(t *testing.T) {
+	_, cleanup := setupConfig(t, "profiles:\n  staging: {}\n")
+	defer cleanup()
+	os.Args = append(os.Args, "--profile", "bogus", "config", "use", "staging")
+	err := BootstrapConfig()
+	assert.NilError(t, err)
+	assert.Equal(t, GlobalConfig.ProfileName(), "")
+
+	_, cleanup = setupConfig(t, "")
+	defer cleanup()
+	os.Args = []string{"kn", "config", "use", "staging"}
+	os.Setenv("KN_PROFILE", "bogus")
+	defer os.Unsetenv("KN_PROFILE")
+	err = BootstrapConfig()
+	assert.NilError(t, err)
+}
+
+func TestBootstrapConfigWithoutProfile(t *testing.T) {
+	_, cleanup := setupConfig(t, "profiles:\n  staging: {}\n")
+	defer cleanup()
+	err := BootstrapConfig()
+	assert.NilError(t, err)
+	assert.Equal(t, GlobalConfig.ProfileName(), "")
+	assert.Assert(t, GlobalConfig.Profile() == nil)
+}
+
 func setupConfig(t *testing.T, configContent string) (string, func()) {
 	tmpDir, err := ioutil.TempDir("", "configContent")
 	assert.NilError(t, err)
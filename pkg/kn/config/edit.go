@@ -0,0 +1,261 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// ProfileKeys are the keys which can be set in a profile
+// with SetProfileValue
+var ProfileKeys = []string{"context", "namespace", "wait-timeout", "revision-name", "lock-to-digest"}
+
+// UseProfile selects the profile with the given name in the configuration file.
+// An empty profile is added if no profile with this name exists yet.
+func UseProfile(configFile string, name string) error {
+	doc, content, err := readConfigFile(configFile)
+	if err != nil {
+		return err
+	}
+	profiles, err := profilesSection(content)
+	if err != nil {
+		return err
+	}
+	if mappingValue(profiles, name) == nil {
+		setMappingValue(profiles, name, &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"})
+	}
+	setMappingValue(content, keyCurrentProfile, stringNode(name))
+	return writeConfigFile(configFile, doc)
+}
+
+// GetProfileValue returns the value for the key from the given profile or
+// an empty string if the key is not set
+func GetProfileValue(configFile string, profileName string, key string) (string, error) {
+	err := validateProfileKey(key)
+	if err != nil {
+		return "", err
+	}
+	_, content, err := readConfigFile(configFile)
+	if err != nil {
+		return "", err
+	}
+	profile, err := lookupProfile(content, profileName)
+	if err != nil {
+		return "", err
+	}
+	value := mappingValue(profile, key)
+	if value == nil || value.Tag == "!!null" {
+		return "", nil
+	}
+	return value.Value, nil
+}
+
+// SetProfileValue sets the key in the given profile to value. An empty value
+// removes the key from the profile. Comments and the order of the entries in
+// the configuration file are kept.
+func SetProfileValue(configFile string, profileName string, key string, value string) error {
+	err := validateProfileKey(key)
+	if err != nil {
+		return err
+	}
+	doc, content, err := readConfigFile(configFile)
+	if err != nil {
+		return err
+	}
+	profile, err := lookupProfile(content, profileName)
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		deleteMappingKey(profile, key)
+		return writeConfigFile(configFile, doc)
+	}
+	switch key {
+	case "wait-timeout":
+		timeout, err := strconv.Atoi(value)
+		if err != nil || timeout < 0 {
+			return errors.Errorf("invalid value '%s' for 'wait-timeout', expected a positive number of seconds", value)
+		}
+		setMappingValue(profile, key, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(timeout)})
+	case "lock-to-digest":
+		lock, err := strconv.ParseBool(value)
+		if err != nil {
+			return errors.Errorf("invalid value '%s' for 'lock-to-digest', expected 'true' or 'false'", value)
+		}
+		setMappingValue(profile, key, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(lock)})
+	default:
+		setMappingValue(profile, key, stringNode(value))
+	}
+	return writeConfigFile(configFile, doc)
+}
+
+// ProfileNames returns the sorted names of all profiles in the configuration file
+func ProfileNames(configFile string) ([]string, error) {
+	_, content, err := readConfigFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := profilesSection(content)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for i := 0; i < len(profiles.Content); i += 2 {
+		names = append(names, profiles.Content[i].Value)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func validateProfileKey(key string) error {
+	for _, k := range ProfileKeys {
+		if k == key {
+			return nil
+		}
+	}
+	return errors.Errorf("unknown profile key '%s', supported keys: %s", key, strings.Join(ProfileKeys, ", "))
+}
+
+func lookupProfile(content *yaml.Node, name string) (*yaml.Node, error) {
+	profiles, err := profilesSection(content)
+	if err != nil {
+		return nil, err
+	}
+	profile := mappingValue(profiles, name)
+	if profile == nil {
+		return nil, errors.Errorf("no profile '%s' found", name)
+	}
+	if profile.Kind == yaml.ScalarNode && profile.Tag == "!!null" {
+		// An empty profile, turn it into a mapping in place to keep its comments
+		profile.Kind, profile.Tag, profile.Value = yaml.MappingNode, "!!map", ""
+	}
+	if profile.Kind != yaml.MappingNode {
+		return nil, errors.Errorf("profile '%s' must be a mapping", name)
+	}
+	if len(profile.Content) == 0 {
+		// Written as {} when empty, switch back to block style for new entries
+		profile.Style = 0
+	}
+	return profile, nil
+}
+
+// profilesSection returns the profiles of the configuration, creating
+// the section if needed
+func profilesSection(content *yaml.Node) (*yaml.Node, error) {
+	profiles := mappingValue(content, keyProfiles)
+	if profiles == nil {
+		profiles = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		setMappingValue(content, keyProfiles, profiles)
+	}
+	if profiles.Kind == yaml.ScalarNode && profiles.Tag == "!!null" {
+		profiles.Kind, profiles.Tag, profiles.Value = yaml.MappingNode, "!!map", ""
+	}
+	if profiles.Kind != yaml.MappingNode {
+		return nil, errors.Errorf("'%s' in the configuration file must be a mapping", keyProfiles)
+	}
+	return profiles, nil
+}
+
+// mappingValue returns the value node for the key in the mapping or nil
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue replaces the value for the key in the mapping or appends
+// the key if it doesn't exist yet
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			// Keep the comments attached to the old value
+			value.HeadComment = mapping.Content[i+1].HeadComment
+			value.LineComment = mapping.Content[i+1].LineComment
+			value.FootComment = mapping.Content[i+1].FootComment
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, stringNode(key), value)
+}
+
+func deleteMappingKey(mapping *yaml.Node, key string) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+func stringNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// readConfigFile parses the configuration file into a document node, so that
+// it can be written back with its comments. It returns the document and its
+// top level mapping.
+func readConfigFile(configFile string) (*yaml.Node, *yaml.Node, error) {
+	doc := &yaml.Node{}
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+	err = yaml.Unmarshal(data, doc)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, fmt.Sprintf("cannot parse configuration file %s", configFile))
+	}
+	if len(doc.Content) == 0 {
+		// Empty or missing file
+		doc = &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	content := doc.Content[0]
+	if content.Kind != yaml.MappingNode {
+		return nil, nil, errors.Errorf("configuration file %s must contain a mapping", configFile)
+	}
+	return doc, content, nil
+}
+
+func writeConfigFile(configFile string, doc *yaml.Node) error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	err := encoder.Encode(doc)
+	if err != nil {
+		return err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(configFile), 0755)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(configFile, buf.Bytes(), 0600)
+}
@@ -0,0 +1,151 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestUseProfile(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "kn-config")
+	assert.NilError(t, err)
+	defer os.RemoveAll(tmpDir)
+	configFile := filepath.Join(tmpDir, "kn", "config.yaml")
+
+	err = UseProfile(configFile, "staging")
+	assert.NilError(t, err)
+	err = SetProfileValue(configFile, "staging", "namespace", "team-a")
+	assert.NilError(t, err)
+	err = UseProfile(configFile, "prod")
+	assert.NilError(t, err)
+	err = UseProfile(configFile, "staging")
+	assert.NilError(t, err)
+
+	names, err := ProfileNames(configFile)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names, []string{"prod", "staging"})
+
+	data, err := ioutil.ReadFile(configFile)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(data), "current-profile: staging\n"))
+
+	// Existing profiles are kept
+	value, err := GetProfileValue(configFile, "staging", "namespace")
+	assert.NilError(t, err)
+	assert.Equal(t, value, "team-a")
+}
+
+func TestSetProfileValue(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "kn-config")
+	assert.NilError(t, err)
+	defer os.RemoveAll(tmpDir)
+	configFile := filepath.Join(tmpDir, "config.yaml")
+	err = ioutil.WriteFile(configFile, []byte("plugins:\n  directory: /tmp\nprofiles:\n  staging:\n"), 0600)
+	assert.NilError(t, err)
+
+	for _, kv := range [][]string{
+		{"context", "staging-cluster"},
+		{"wait-timeout", "120"},
+		{"lock-to-digest", "false"},
+		{"revision-name", "{{.Service}}-{{.Generation}}"},
+	} {
+		err = SetProfileValue(configFile, "staging", kv[0], kv[1])
+		assert.NilError(t, err)
+		value, err := GetProfileValue(configFile, "staging", kv[0])
+		assert.NilError(t, err)
+		assert.Equal(t, value, kv[1])
+	}
+
+	// Values are written with their types and other settings are kept
+	data, err := ioutil.ReadFile(configFile)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `plugins:
+  directory: /tmp
+profiles:
+  staging:
+    context: staging-cluster
+    wait-timeout: 120
+    lock-to-digest: false
+    revision-name: '{{.Service}}-{{.Generation}}'
+`)
+
+	// Empty value removes the key
+	err = SetProfileValue(configFile, "staging", "context", "")
+	assert.NilError(t, err)
+	value, err := GetProfileValue(configFile, "staging", "context")
+	assert.NilError(t, err)
+	assert.Equal(t, value, "")
+
+	err = SetProfileValue(configFile, "staging", "wait-timeout", "-1")
+	assert.ErrorContains(t, err, "invalid value '-1' for 'wait-timeout'")
+	err = SetProfileValue(configFile, "staging", "lock-to-digest", "maybe")
+	assert.ErrorContains(t, err, "invalid value 'maybe' for 'lock-to-digest'")
+	err = SetProfileValue(configFile, "staging", "color", "blue")
+	assert.ErrorContains(t, err, "unknown profile key 'color'")
+	err = SetProfileValue(configFile, "prod", "context", "prod-cluster")
+	assert.ErrorContains(t, err, "no profile 'prod' found")
+}
+
+func TestEditKeepsCommentsAndOrder(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "kn-config")
+	assert.NilError(t, err)
+	defer os.RemoveAll(tmpDir)
+	configFile := filepath.Join(tmpDir, "config.yaml")
+	original := `# team settings
+profiles:
+  # the production cluster
+  prod:
+    namespace: prod # never change this
+    context: prod-cluster
+plugins:
+  directory: /tmp
+current-profile: prod
+`
+	err = ioutil.WriteFile(configFile, []byte(original), 0600)
+	assert.NilError(t, err)
+
+	err = UseProfile(configFile, "dev")
+	assert.NilError(t, err)
+	err = SetProfileValue(configFile, "prod", "wait-timeout", "60")
+	assert.NilError(t, err)
+	err = SetProfileValue(configFile, "dev", "revision-name", "true")
+	assert.NilError(t, err)
+
+	data, err := ioutil.ReadFile(configFile)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `# team settings
+profiles:
+  # the production cluster
+  prod:
+    namespace: prod # never change this
+    context: prod-cluster
+    wait-timeout: 60
+  dev:
+    revision-name: "true"
+plugins:
+  directory: /tmp
+current-profile: dev
+`)
+
+	value, err := GetProfileValue(configFile, "dev", "revision-name")
+	assert.NilError(t, err)
+	assert.Equal(t, value, "true")
+}
@@ -21,13 +21,27 @@ type TestConfig struct {
 	TestPluginsDir          string
 	TestConfigFile          string
 	TestLookupPluginsInPath bool
+	TestPluginIndex         string
+	TestPluginConfigs       map[string]map[string]interface{}
 	TestSinkMappings        []SinkMapping
+	TestAliases             map[string][]string
+	TestDefaultFlags        map[string][]string
+	TestProfileName         string
+	TestProfile             *Profile
 }
 
 // Ensure that TestConfig implements the configuration interface
 var _ Config = &TestConfig{}
 
-func (t TestConfig) PluginsDir() string          { return t.TestPluginsDir }
-func (t TestConfig) ConfigFile() string          { return t.TestConfigFile }
-func (t TestConfig) LookupPluginsInPath() bool   { return t.TestLookupPluginsInPath }
-func (t TestConfig) SinkMappings() []SinkMapping { return t.TestSinkMappings }
+func (t TestConfig) PluginsDir() string                { return t.TestPluginsDir }
+func (t TestConfig) ConfigFile() string                { return t.TestConfigFile }
+func (t TestConfig) LookupPluginsInPath() bool         { return t.TestLookupPluginsInPath }
+func (t TestConfig) PluginIndex() string               { return t.TestPluginIndex }
+func (t TestConfig) SinkMappings() []SinkMapping       { return t.TestSinkMappings }
+func (t TestConfig) Aliases() map[string][]string      { return t.TestAliases }
+func (t TestConfig) DefaultFlags() map[string][]string { return t.TestDefaultFlags }
+func (t TestConfig) ProfileName() string               { return t.TestProfileName }
+func (t TestConfig) Profile() *Profile                 { return t.TestProfile }
+func (t TestConfig) PluginConfig(name string) map[string]interface{} {
+	return t.TestPluginConfigs[name]
+}
@@ -21,17 +21,20 @@ import (
 )
 
 // Dummy test to keep code coverage quality gate happy.
-//
 func TestTestConfig(t *testing.T) {
 	cfg := TestConfig{
 		TestPluginsDir:          "pluginsDir",
 		TestConfigFile:          "configFile",
 		TestLookupPluginsInPath: true,
+		TestPluginIndex:         "pluginIndex",
+		TestPluginConfigs:       map[string]map[string]interface{}{"test": {"key": "value"}},
 		TestSinkMappings:        nil,
 	}
 
 	assert.Equal(t, cfg.PluginsDir(), "pluginsDir")
 	assert.Equal(t, cfg.ConfigFile(), "configFile")
 	assert.Assert(t, cfg.LookupPluginsInPath())
+	assert.Equal(t, cfg.PluginIndex(), "pluginIndex")
+	assert.Equal(t, cfg.PluginConfig("test")["key"], "value")
 	assert.Assert(t, cfg.SinkMappings() == nil)
 }
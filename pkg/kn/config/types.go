@@ -29,8 +29,53 @@ type Config interface {
 	// in the execution path
 	LookupPluginsInPath() bool
 
+	// PluginIndex returns the location of the index used for
+	// installing plugins
+	PluginIndex() string
+
+	// PluginConfig returns the configuration section 'plugins.<name>'
+	// for the plugin with the given name
+	PluginConfig(name string) map[string]interface{}
+
 	// SinkMappings returns additional mappings for sink prefixes to resources
 	SinkMappings() []SinkMapping
+
+	// Aliases returns a map of alias names to the arguments the alias expands to
+	Aliases() map[string][]string
+
+	// DefaultFlags returns a map of command paths (like "service create") to the
+	// flags which are added to each invocation of the command
+	DefaultFlags() map[string][]string
+
+	// ProfileName returns the name of the selected profile or an empty
+	// string if no profile is used
+	ProfileName() string
+
+	// Profile returns the selected profile or nil if no profile is used
+	Profile() *Profile
+}
+
+// Profile holds defaults for working with a specific environment. A profile is
+// selected with --profile, $KN_PROFILE or the current-profile setting.
+type Profile struct {
+
+	// Context is the kubeconfig context to use
+	Context string `mapstructure:"context" json:"context,omitempty"`
+
+	// Namespace is the namespace to use if not given with --namespace
+	Namespace string `mapstructure:"namespace" json:"namespace,omitempty"`
+
+	// WaitTimeout is the default for --wait-timeout in seconds
+	WaitTimeout *int `mapstructure:"wait-timeout" json:"wait-timeout,omitempty"`
+
+	// RevisionName is the default template for --revision-name
+	RevisionName *string `mapstructure:"revision-name" json:"revision-name,omitempty"`
+
+	// LockToDigest is the default for --lock-to-digest
+	LockToDigest *bool `mapstructure:"lock-to-digest" json:"lock-to-digest,omitempty"`
+
+	// SinkMappings are added to the global sink mappings
+	SinkMappings []SinkMapping `mapstructure:"sink-mappings" json:"sink-mappings,omitempty"`
 }
 
 // SinkMappings is the struct of sink prefix config in kn config
@@ -53,6 +98,12 @@ type SinkMapping struct {
 const (
 	keyPluginsDirectory    = "plugins.directory"
 	keyPluginsLookupInPath = "plugins.path-lookup"
+	keyPluginsIndex        = "plugins.index"
+	keyPluginsPrefix       = "plugins."
+	keyCurrentProfile      = "current-profile"
+	keyAliases             = "aliases"
+	keyDefaultFlags        = "default-flags"
+	keyProfiles            = "profiles"
 	keySinkMappings        = "eventing.sink-mappings"
 )
 
@@ -69,3 +120,9 @@ const (
 	flagPluginsDir          = "plugins-dir"
 	flagPluginsLookupInPath = "lookup-plugins"
 )
+
+// Global flag and environment variable for selecting a profile
+const (
+	flagProfile = "profile"
+	envProfile  = "KN_PROFILE"
+)
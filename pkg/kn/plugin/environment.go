@@ -0,0 +1,100 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Environment variables which kn exports to plugins
+const (
+	// EnvConfigFile is the location of kn's configuration file
+	EnvConfigFile = "KN_CONFIG"
+
+	// EnvKubeConfig is the kubeconfig file (or list of files) used by kn
+	EnvKubeConfig = "KN_KUBECONFIG"
+
+	// EnvContext is the kubeconfig context used by kn, either from the
+	// selected profile or the current context of the kubeconfig
+	EnvContext = "KN_CONTEXT"
+
+	// EnvNamespace is the namespace given with --namespace or the current
+	// namespace from the kubeconfig
+	EnvNamespace = "KN_NAMESPACE"
+
+	// EnvPluginsDir is kn's plugin directory
+	EnvPluginsDir = "KN_PLUGINS_DIR"
+
+	// EnvPluginConfig holds the plugin's own configuration section
+	// 'plugins.<name>' from kn's configuration file as JSON
+	EnvPluginConfig = "KN_PLUGIN_CONFIG"
+)
+
+// Context holds the resolved settings of kn which are handed over
+// to a plugin via environment variables
+type Context struct {
+	ConfigFile   string
+	KubeConfig   string
+	Context      string
+	Namespace    string
+	PluginsDir   string
+	PluginConfig map[string]interface{}
+}
+
+// Environ returns the KN_* environment variables in the form "key=value"
+// for passing the context to a plugin
+func (ctx Context) Environ() ([]string, error) {
+	env := []string{
+		EnvConfigFile + "=" + ctx.ConfigFile,
+		EnvKubeConfig + "=" + ctx.KubeConfig,
+		EnvContext + "=" + ctx.Context,
+		EnvNamespace + "=" + ctx.Namespace,
+		EnvPluginsDir + "=" + ctx.PluginsDir,
+	}
+	pluginConfig := "{}"
+	if len(ctx.PluginConfig) > 0 {
+		data, err := json.Marshal(ctx.PluginConfig)
+		if err != nil {
+			return nil, fmt.Errorf("cannot serialize plugin configuration: %v", err)
+		}
+		pluginConfig = string(data)
+	}
+	return append(env, EnvPluginConfig+"="+pluginConfig), nil
+}
+
+// ConfigName returns the name under which the configuration of the given
+// plugin is looked up in kn's configuration file (i.e. 'plugins.<name>')
+func ConfigName(plugin Plugin) string {
+	return strings.Join(plugin.CommandParts(), "-")
+}
+
+// mergeEnviron adds the given variables to the current process environment,
+// replacing any variable with the same name
+func mergeEnviron(env []string) []string {
+	override := map[string]bool{}
+	for _, e := range env {
+		override[strings.SplitN(e, "=", 2)[0]] = true
+	}
+	var ret []string
+	for _, e := range os.Environ() {
+		if !override[strings.SplitN(e, "=", 2)[0]] {
+			ret = append(ret, e)
+		}
+	}
+	return append(ret, env...)
+}
@@ -0,0 +1,77 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestContextEnviron(t *testing.T) {
+	ctx := Context{
+		ConfigFile:   "/kn/config.yaml",
+		KubeConfig:   "/kube/config",
+		Context:      "dev",
+		Namespace:    "foo",
+		PluginsDir:   "/kn/plugins",
+		PluginConfig: map[string]interface{}{"bootstrap": "kafka:9092", "topics": []interface{}{"a", "b"}},
+	}
+	env, err := ctx.Environ()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, env, []string{
+		"KN_CONFIG=/kn/config.yaml",
+		"KN_KUBECONFIG=/kube/config",
+		"KN_CONTEXT=dev",
+		"KN_NAMESPACE=foo",
+		"KN_PLUGINS_DIR=/kn/plugins",
+		`KN_PLUGIN_CONFIG={"bootstrap":"kafka:9092","topics":["a","b"]}`,
+	})
+
+	env, err = Context{}.Environ()
+	assert.NilError(t, err)
+	assert.Equal(t, env[len(env)-1], "KN_PLUGIN_CONFIG={}")
+}
+
+func TestConfigName(t *testing.T) {
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	createTestPlugin(t, "kn-source-log_all", ctx)
+
+	plugin, err := ctx.pluginManager.FindPlugin([]string{"source", "log-all"})
+	assert.NilError(t, err)
+	assert.Equal(t, ConfigName(plugin), "source-log-all")
+}
+
+func TestExecuteWithEnvironment(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts are shell scripts")
+	}
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	createTestPluginWithScript(t, "kn-env", "#!/bin/sh\necho \"$KN_NAMESPACE $KN_OTHER\"\n", ctx)
+	os.Setenv("KN_NAMESPACE", "outer")
+	os.Setenv("KN_OTHER", "kept")
+	defer os.Unsetenv("KN_NAMESPACE")
+	defer os.Unsetenv("KN_OTHER")
+
+	plugin, err := ctx.pluginManager.FindPlugin([]string{"env"})
+	assert.NilError(t, err)
+	out, err := executePluginWithEnv(plugin, nil, []string{"KN_NAMESPACE=inner"})
+	assert.NilError(t, err)
+	assert.Equal(t, out, "inner kept\n")
+}
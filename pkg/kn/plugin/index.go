@@ -0,0 +1,152 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// Index is a manifest listing plugins which can be installed
+// with 'kn plugin install'
+type Index struct {
+	// Plugins available in this index
+	Plugins []IndexEntry `json:"plugins"`
+
+	// location from where the index has been loaded, used
+	// for resolving relative download URLs
+	location string
+}
+
+// IndexEntry describes a single plugin in an index
+type IndexEntry struct {
+	// Name of the plugin without the "kn-" prefix (like "source-kafka")
+	Name string `json:"name"`
+
+	// Version of the plugin (like "v0.15.0")
+	Version string `json:"version"`
+
+	// Description is a short, human readable description of the plugin
+	Description string `json:"description,omitempty"`
+
+	// Platforms holds the downloads for the supported platforms
+	Platforms []IndexPlatform `json:"platforms"`
+}
+
+// IndexPlatform is the download of a plugin for a single platform
+type IndexPlatform struct {
+	// OS as used by GOOS (like "linux", "darwin" or "windows")
+	OS string `json:"os"`
+
+	// Arch as used by GOARCH (like "amd64")
+	Arch string `json:"arch"`
+
+	// URL to download the plugin binary from. Relative URLs are resolved
+	// against the location of the index
+	URL string `json:"url"`
+
+	// Sha256 is the hex encoded SHA-256 checksum of the plugin binary
+	Sha256 string `json:"sha256"`
+}
+
+// LoadIndex reads a plugin index from a local file or an HTTP(S) URL
+func LoadIndex(location string) (*Index, error) {
+	data, err := readLocation(location)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot read plugin index %s", location))
+	}
+	index := &Index{}
+	err = yaml.Unmarshal(data, index)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot parse plugin index %s", location))
+	}
+	index.location = location
+	return index, nil
+}
+
+// Location returns from where this index has been loaded
+func (index *Index) Location() string {
+	return index.location
+}
+
+// Lookup returns the entry for the plugin with the given name or
+// an error if the index doesn't contain such a plugin
+func (index *Index) Lookup(name string) (*IndexEntry, error) {
+	for i := range index.Plugins {
+		if index.Plugins[i].Name == name {
+			return &index.Plugins[i], nil
+		}
+	}
+	return nil, errors.Errorf("no plugin '%s' found in plugin index %s", name, index.location)
+}
+
+// Platform returns the download for the current OS and architecture
+func (entry *IndexEntry) Platform() (*IndexPlatform, error) {
+	for i := range entry.Platforms {
+		platform := &entry.Platforms[i]
+		if platform.OS == runtime.GOOS && platform.Arch == runtime.GOARCH {
+			return platform, nil
+		}
+	}
+	return nil, errors.Errorf("plugin '%s' %s is not available for %s/%s", entry.Name, entry.Version, runtime.GOOS, runtime.GOARCH)
+}
+
+// resolve returns the location of a download URL, taking the
+// index location as base for relative URLs
+func (index *Index) resolve(download string) (string, error) {
+	if isHTTP(download) || filepath.IsAbs(download) || index.location == "" {
+		return download, nil
+	}
+	if isHTTP(index.location) {
+		base, err := url.Parse(index.location)
+		if err != nil {
+			return "", err
+		}
+		ref, err := url.Parse(download)
+		if err != nil {
+			return "", err
+		}
+		return base.ResolveReference(ref).String(), nil
+	}
+	return filepath.Join(filepath.Dir(index.location), filepath.FromSlash(download)), nil
+}
+
+// readLocation reads the content from an HTTP(S) URL or a local file
+func readLocation(location string) ([]byte, error) {
+	if !isHTTP(location) {
+		return ioutil.ReadFile(location)
+	}
+	resp, err := http.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected response status %s", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func isHTTP(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
@@ -0,0 +1,304 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// Directory within the plugins directory which holds the
+// metadata of plugins installed from an index
+const installedDir = ".installed"
+
+// InstalledPlugin records a plugin which has been installed from an index
+type InstalledPlugin struct {
+	// Name of the plugin as used in the index
+	Name string `json:"name"`
+
+	// Version which has been installed
+	Version string `json:"version"`
+
+	// Sha256 checksum of the plugin binary as declared in the index
+	Sha256 string `json:"sha256"`
+
+	// Index from which the plugin has been installed
+	Index string `json:"index"`
+}
+
+// Install downloads the plugin with the given name from the index into the
+// plugins directory. The download is verified against the checksum
+// declared in the index.
+func (manager *Manager) Install(index *Index, name string) (*InstalledPlugin, error) {
+	name, err := validatePluginName(name)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := manager.expandedPluginsDir()
+	if err != nil {
+		return nil, err
+	}
+	existing, err := findInDirOrPath("kn-"+name, dir, false)
+	if err != nil {
+		return nil, err
+	}
+	if existing != "" {
+		return nil, errors.Errorf("plugin '%s' is already installed as %s, use 'kn plugin upgrade' to update it", name, existing)
+	}
+	return manager.download(index, name, dir)
+}
+
+// Upgrade replaces a plugin installed from an index with the version
+// currently listed in the index. The previously installed version is
+// returned along with the new one. Both are the same if the plugin is
+// already up to date.
+func (manager *Manager) Upgrade(index *Index, name string) (*InstalledPlugin, *InstalledPlugin, error) {
+	name, err := validatePluginName(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	dir, err := manager.expandedPluginsDir()
+	if err != nil {
+		return nil, nil, err
+	}
+	installed, err := manager.InstalledPlugin(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if installed == nil {
+		return nil, nil, errors.Errorf("plugin '%s' has not been installed from a plugin index", name)
+	}
+	entry, err := index.Lookup(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if entry.Version == installed.Version {
+		return installed, installed, nil
+	}
+	upgraded, err := manager.download(index, name, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	return installed, upgraded, nil
+}
+
+// Uninstall removes the plugin with the given name from the plugins
+// directory. Plugins found only in the execution path are not touched.
+func (manager *Manager) Uninstall(name string) error {
+	name, err := validatePluginName(name)
+	if err != nil {
+		return err
+	}
+	dir, err := manager.expandedPluginsDir()
+	if err != nil {
+		return err
+	}
+	pluginPath, err := findInDirOrPath("kn-"+name, dir, false)
+	if err != nil {
+		return err
+	}
+	if pluginPath == "" {
+		return errors.Errorf("plugin '%s' is not installed in plugins directory %s", name, dir)
+	}
+	err = os.Remove(pluginPath)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("cannot remove plugin %s", pluginPath))
+	}
+	err = os.Remove(installedFile(dir, name))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// InstalledPlugin returns the installation record of the plugin with the
+// given name or nil if the plugin has not been installed from an index
+func (manager *Manager) InstalledPlugin(name string) (*InstalledPlugin, error) {
+	dir, err := manager.expandedPluginsDir()
+	if err != nil {
+		return nil, err
+	}
+	return readInstalledPlugin(installedFile(dir, name))
+}
+
+// InstalledPlugins returns the installation records of all plugins which
+// have been installed from an index
+func (manager *Manager) InstalledPlugins() ([]*InstalledPlugin, error) {
+	dir, err := manager.expandedPluginsDir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(filepath.Join(dir, installedDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var plugins []*InstalledPlugin
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".yaml" {
+			continue
+		}
+		installed, err := readInstalledPlugin(filepath.Join(dir, installedDir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, installed)
+	}
+	return plugins, nil
+}
+
+// =========================================================================================
+
+// download fetches the binary of the plugin for the current platform, verifies
+// its checksum and stores it together with its installation record
+func (manager *Manager) download(index *Index, name string, dir string) (*InstalledPlugin, error) {
+	entry, err := index.Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	platform, err := entry.Platform()
+	if err != nil {
+		return nil, err
+	}
+	location, err := index.resolve(platform.URL)
+	if err != nil {
+		return nil, err
+	}
+	data, err := readLocation(location)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot download plugin '%s' from %s", name, location))
+	}
+	checksum := sha256Sum(data)
+	if !strings.EqualFold(checksum, platform.Sha256) {
+		return nil, errors.Errorf("checksum of plugin '%s' downloaded from %s does not match the plugin index (expected: %s, actual: %s)",
+			name, location, platform.Sha256, checksum)
+	}
+
+	err = os.MkdirAll(filepath.Join(dir, installedDir), 0755)
+	if err != nil {
+		return nil, err
+	}
+	pluginPath := filepath.Join(dir, "kn-"+name+executableExtension(location))
+
+	// Write to a temporary file first so that a running plugin isn't truncated
+	tmpFile, err := ioutil.TempFile(dir, ".kn-"+name)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmpFile.Name())
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = os.Chmod(tmpFile.Name(), 0755)
+	if err != nil {
+		return nil, err
+	}
+	err = os.Rename(tmpFile.Name(), pluginPath)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot install plugin %s", pluginPath))
+	}
+
+	installed := &InstalledPlugin{
+		Name:    name,
+		Version: entry.Version,
+		Sha256:  strings.ToLower(platform.Sha256),
+		Index:   index.Location(),
+	}
+	err = writeInstalledPlugin(installedFile(dir, name), installed)
+	if err != nil {
+		return nil, err
+	}
+	return installed, nil
+}
+
+// Expand the configured plugins directory
+func (manager *Manager) expandedPluginsDir() (string, error) {
+	return homedir.Expand(manager.pluginsDir)
+}
+
+// validatePluginName checks that a name can be used for a plugin file and strips
+// an optional "kn-" prefix
+func validatePluginName(name string) (string, error) {
+	name = strings.TrimPrefix(name, "kn-")
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return "", errors.Errorf("invalid plugin name '%s'", name)
+	}
+	return name, nil
+}
+
+// executableExtension returns the extension to use for a downloaded plugin
+// binary. Only on Windows an extension is required.
+func executableExtension(location string) string {
+	if runtime.GOOS != "windows" {
+		return ""
+	}
+	ext := path.Ext(location)
+	for _, e := range windowsExecExtensions {
+		if ext == e {
+			return ext
+		}
+	}
+	return ".exe"
+}
+
+func installedFile(dir string, name string) string {
+	return filepath.Join(dir, installedDir, name+".yaml")
+}
+
+func readInstalledPlugin(file string) (*InstalledPlugin, error) {
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	installed := &InstalledPlugin{}
+	err = yaml.Unmarshal(data, installed)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot parse %s", file))
+	}
+	return installed, nil
+}
+
+func writeInstalledPlugin(file string, installed *InstalledPlugin) error {
+	data, err := yaml.Marshal(installed)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, data, 0644)
+}
+
+func sha256Sum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
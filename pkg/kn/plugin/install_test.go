@@ -0,0 +1,203 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestLoadIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/index/plugins.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, testIndex("test", "v0.1.0", "kn-test", "0000"))
+	}))
+	defer server.Close()
+
+	index, err := LoadIndex(server.URL + "/index/plugins.yaml")
+	assert.NilError(t, err)
+	entry, err := index.Lookup("test")
+	assert.NilError(t, err)
+	assert.Equal(t, entry.Version, "v0.1.0")
+	platform, err := entry.Platform()
+	assert.NilError(t, err)
+	location, err := index.resolve(platform.URL)
+	assert.NilError(t, err)
+	assert.Equal(t, location, server.URL+"/index/kn-test")
+
+	_, err = index.Lookup("bogus")
+	assert.ErrorContains(t, err, "no plugin 'bogus'")
+
+	_, err = LoadIndex(server.URL + "/bogus.yaml")
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestInstallUpgradeUninstall(t *testing.T) {
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	indexDir, indexFile := prepareTestIndex(t, "v0.1.0", "first")
+	defer os.RemoveAll(indexDir)
+
+	index, err := LoadIndex(indexFile)
+	assert.NilError(t, err)
+	installed, err := ctx.pluginManager.Install(index, "kn-test")
+	assert.NilError(t, err)
+	assert.Equal(t, installed.Name, "test")
+	assert.Equal(t, installed.Version, "v0.1.0")
+	assert.Equal(t, installed.Index, indexFile)
+
+	plugin, err := ctx.pluginManager.FindPlugin([]string{"test"})
+	assert.NilError(t, err)
+	assert.Assert(t, plugin != nil)
+	content, err := ioutil.ReadFile(plugin.Path())
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "first")
+	eaw := ctx.pluginManager.Verify()
+	assert.Assert(t, eaw.IsEmpty(), "%v", eaw)
+
+	_, err = ctx.pluginManager.Install(index, "test")
+	assert.ErrorContains(t, err, "already installed")
+
+	// Same version in index
+	previous, upgraded, err := ctx.pluginManager.Upgrade(index, "test")
+	assert.NilError(t, err)
+	assert.Equal(t, previous.Version, upgraded.Version)
+
+	// New version in index
+	writeTestIndex(t, indexDir, "v0.2.0", "second")
+	index, err = LoadIndex(indexFile)
+	assert.NilError(t, err)
+	previous, upgraded, err = ctx.pluginManager.Upgrade(index, "test")
+	assert.NilError(t, err)
+	assert.Equal(t, previous.Version, "v0.1.0")
+	assert.Equal(t, upgraded.Version, "v0.2.0")
+	content, err = ioutil.ReadFile(plugin.Path())
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "second")
+
+	installedPlugins, err := ctx.pluginManager.InstalledPlugins()
+	assert.NilError(t, err)
+	assert.Equal(t, len(installedPlugins), 1)
+	assert.Equal(t, installedPlugins[0].Version, "v0.2.0")
+
+	assert.NilError(t, ctx.pluginManager.Uninstall("test"))
+	plugin, err = ctx.pluginManager.FindPlugin([]string{"test"})
+	assert.NilError(t, err)
+	assert.Assert(t, plugin == nil)
+	installedPlugins, err = ctx.pluginManager.InstalledPlugins()
+	assert.NilError(t, err)
+	assert.Equal(t, len(installedPlugins), 0)
+
+	err = ctx.pluginManager.Uninstall("test")
+	assert.ErrorContains(t, err, "not installed")
+}
+
+func TestInstallWithChecksumMismatch(t *testing.T) {
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	indexDir, indexFile := prepareTestIndex(t, "v0.1.0", "first")
+	defer os.RemoveAll(indexDir)
+
+	// Tamper with the download
+	err := ioutil.WriteFile(filepath.Join(indexDir, "kn-test"), []byte("evil"), 0644)
+	assert.NilError(t, err)
+
+	index, err := LoadIndex(indexFile)
+	assert.NilError(t, err)
+	_, err = ctx.pluginManager.Install(index, "test")
+	assert.ErrorContains(t, err, "does not match the plugin index")
+	plugin, err := ctx.pluginManager.FindPlugin([]string{"test"})
+	assert.NilError(t, err)
+	assert.Assert(t, plugin == nil)
+}
+
+func TestUpgradeNotInstalledFromIndex(t *testing.T) {
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	createTestPlugin(t, "kn-test", ctx)
+	indexDir, indexFile := prepareTestIndex(t, "v0.1.0", "first")
+	defer os.RemoveAll(indexDir)
+
+	index, err := LoadIndex(indexFile)
+	assert.NilError(t, err)
+	_, _, err = ctx.pluginManager.Upgrade(index, "test")
+	assert.ErrorContains(t, err, "not been installed from a plugin index")
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	indexDir, indexFile := prepareTestIndex(t, "v0.1.0", "first")
+	defer os.RemoveAll(indexDir)
+
+	index, err := LoadIndex(indexFile)
+	assert.NilError(t, err)
+	_, err = ctx.pluginManager.Install(index, "test")
+	assert.NilError(t, err)
+	plugin, err := ctx.pluginManager.FindPlugin([]string{"test"})
+	assert.NilError(t, err)
+
+	err = ioutil.WriteFile(plugin.Path(), []byte("modified"), 0755)
+	assert.NilError(t, err)
+	eaw := ctx.pluginManager.Verify()
+	assert.Equal(t, len(eaw.Errors), 1)
+	assert.Assert(t, util.ContainsAll(eaw.Errors[0], plugin.Path(), "checksum", "v0.1.0"))
+}
+
+// Prepare a directory with an index and a plugin binary with the given content
+func prepareTestIndex(t *testing.T, version string, content string) (string, string) {
+	dir, err := ioutil.TempDir("", "plugin_index")
+	assert.NilError(t, err)
+	return dir, writeTestIndex(t, dir, version, content)
+}
+
+func writeTestIndex(t *testing.T, dir string, version string, content string) string {
+	binary := "kn-test"
+	if runtime.GOOS == "windows" {
+		binary += ".exe"
+	}
+	err := ioutil.WriteFile(filepath.Join(dir, binary), []byte(content), 0644)
+	assert.NilError(t, err)
+	indexFile := filepath.Join(dir, "plugins.yaml")
+	err = ioutil.WriteFile(indexFile, []byte(testIndex("test", version, binary, sha256Sum([]byte(content)))), 0644)
+	assert.NilError(t, err)
+	return indexFile
+}
+
+func testIndex(name string, version string, url string, checksum string) string {
+	return fmt.Sprintf(`
+plugins:
+- name: %s
+  version: %s
+  platforms:
+  - os: %s
+    arch: %s
+    url: %s
+    sha256: %s
+`, name, version, runtime.GOOS, runtime.GOARCH, url, checksum)
+}
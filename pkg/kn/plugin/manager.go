@@ -33,12 +33,17 @@ type Plugin interface {
 	// Get the name of the plugin (the file name without extensions)
 	Name() string
 
-	// Execute the plugin with the given arguments
-	Execute(args []string) error
+	// Execute the plugin with the given arguments. The environment variables
+	// given in the form "key=value" are added to kn's own environment.
+	Execute(args []string, env []string) error
 
 	// Return a description of the plugin (if support by the plugin binary)
 	Description() (string, error)
 
+	// Metadata returns the metadata provided by the plugin binary or nil
+	// if the plugin doesn't support the metadata protocol
+	Metadata() (*Metadata, error)
+
 	// The command path leading to this plugin.
 	// Eg. for a plugin "kn source github" this will be [ "source", "github" ]
 	CommandParts() []string
@@ -163,23 +168,34 @@ func (manager *Manager) LookupInPath() bool {
 
 // === Plugin ==============================================================================
 
-// Execute the plugin with the given arguments
-func (plugin *plugin) Execute(args []string) error {
+// Execute the plugin with the given arguments and additional environment variables
+func (plugin *plugin) Execute(args []string, env []string) error {
 	cmd := exec.Command(plugin.path, args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	cmd.Stdin = os.Stdin
-	cmd.Env = os.Environ()
+	cmd.Env = mergeEnviron(env)
 	return cmd.Run()
 }
 
 // Return a description of the plugin (if support by the plugin binary)
 func (plugin *plugin) Description() (string, error) {
-	// TODO: Call out to the plugin to find a description.
-	// For now just use the plugin name
+	metadata, err := plugin.Metadata()
+	if err != nil {
+		return "", err
+	}
+	if metadata != nil && metadata.Description != "" {
+		return metadata.Description, nil
+	}
+	// Fallback to the plugin name for plugins not providing a description
 	return strings.Join(plugin.commandParts, "-"), nil
 }
 
+// Metadata returns the metadata provided by the plugin binary
+func (plugin *plugin) Metadata() (*Metadata, error) {
+	return lookupMetadata(plugin.path)
+}
+
 // The the command path leading to this plugin.
 // Eg. for a plugin "kn source github" this will be [ "source", "github" ]
 func (plugin *plugin) CommandParts() []string {
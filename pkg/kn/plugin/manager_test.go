@@ -165,13 +165,17 @@ func cleanup(t *testing.T, ctx testContext) {
 }
 
 func executePlugin(plugin Plugin, args []string) (string, error) {
+	return executePluginWithEnv(plugin, args, nil)
+}
+
+func executePluginWithEnv(plugin Plugin, args []string, env []string) (string, error) {
 	rescueStdout := os.Stdout
 	defer (func() { os.Stdout = rescueStdout })()
 
 	r, w, _ := os.Pipe()
 	os.Stdout = w
 
-	err := plugin.Execute(args)
+	err := plugin.Execute(args, env)
 	w.Close()
 	if err != nil {
 		return "", err
@@ -0,0 +1,158 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MetadataFlag is the option with which a plugin is called to print out
+// its metadata as JSON
+const MetadataFlag = "--kn-plugin-metadata"
+
+// Time to wait for a plugin to return its metadata
+var metadataTimeout = 2 * time.Second
+
+// Metadata already read, so that every plugin is called at most once
+// per kn invocation
+var (
+	metadataCache      = map[metadataKey]metadataResult{}
+	metadataCacheMutex sync.Mutex
+)
+
+// metadataKey identifies a specific version of a plugin binary
+type metadataKey struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+type metadataResult struct {
+	metadata *Metadata
+	err      error
+}
+
+// Metadata describes a plugin. It is printed as JSON by plugins supporting
+// the metadata protocol when called with --kn-plugin-metadata, e.g.:
+//
+//	{
+//	  "description": "Manage Kafka sources",
+//	  "version": "v0.15.0",
+//	  "minKnVersion": "v0.15.0",
+//	  "subcommands": [
+//	    { "name": "create", "description": "Create a Kafka source",
+//	      "flags": [ { "name": "topics", "description": "Topics to consume" } ] }
+//	  ]
+//	}
+type Metadata struct {
+	CommandMetadata
+
+	// Version of the plugin
+	Version string `json:"version,omitempty"`
+
+	// MinKnVersion is the minimal version of kn the plugin works with
+	MinKnVersion string `json:"minKnVersion,omitempty"`
+}
+
+// CommandMetadata describes the plugin itself or one of its sub-commands
+type CommandMetadata struct {
+	// Name of the sub-command, empty for the plugin itself
+	Name string `json:"name,omitempty"`
+
+	// Description is a short, single line description
+	Description string `json:"description,omitempty"`
+
+	// Flags supported by the command
+	Flags []FlagMetadata `json:"flags,omitempty"`
+
+	// Subcommands of the command
+	Subcommands []CommandMetadata `json:"subcommands,omitempty"`
+}
+
+// FlagMetadata describes a flag of a plugin command
+type FlagMetadata struct {
+	// Name of the flag without leading dashes
+	Name string `json:"name"`
+
+	// Shorthand is the single letter short option (optional)
+	Shorthand string `json:"shorthand,omitempty"`
+
+	// Description of the flag
+	Description string `json:"description,omitempty"`
+}
+
+// Subcommand returns the metadata of the sub-command reached by the given
+// path of command names or nil if no such sub-command is declared
+func (c *CommandMetadata) Subcommand(path []string) *CommandMetadata {
+	if len(path) == 0 {
+		return c
+	}
+	for i := range c.Subcommands {
+		if c.Subcommands[i].Name == path[0] {
+			return c.Subcommands[i].Subcommand(path[1:])
+		}
+	}
+	return nil
+}
+
+// lookupMetadata returns the metadata of the plugin at the given path, calling
+// the plugin only if its metadata hasn't been read before
+func lookupMetadata(path string) (*Metadata, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	key := metadataKey{path: path, size: info.Size(), modTime: info.ModTime()}
+
+	metadataCacheMutex.Lock()
+	defer metadataCacheMutex.Unlock()
+	result, ok := metadataCache[key]
+	if !ok {
+		result.metadata, result.err = readMetadata(path)
+		metadataCache[key] = result
+	}
+	return result.metadata, result.err
+}
+
+// readMetadata calls the plugin with --kn-plugin-metadata and parses its output.
+// nil is returned without an error if the plugin doesn't support the protocol,
+// i.e. when it exits with an error or doesn't print a JSON object.
+func readMetadata(path string) (*Metadata, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), metadataTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, path, MetadataFlag).Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, errors.Errorf("plugin %s did not return its metadata within %s", path, metadataTimeout)
+	}
+	out = bytes.TrimSpace(out)
+	if err != nil || !bytes.HasPrefix(out, []byte("{")) {
+		return nil, nil
+	}
+	metadata := &Metadata{}
+	err = json.Unmarshal(out, metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot parse metadata of plugin %s", path))
+	}
+	return metadata, nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/kn/commands/version"
+	"knative.dev/client/pkg/util"
+)
+
+const testMetadataScript = `#!/bin/sh
+if [ "$1" = "--kn-plugin-metadata" ]; then
+  cat <<EOT
+{
+  "description": "Manage Kafka sources",
+  "version": "v0.2.0",
+  "minKnVersion": "v0.16.0",
+  "subcommands": [
+    { "name": "create", "flags": [ { "name": "topic", "description": "Topic to consume" } ] },
+    { "name": "describe", "subcommands": [ { "name": "deep" } ] }
+  ]
+}
+EOT
+fi
+`
+
+func TestPluginMetadata(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts are shell scripts")
+	}
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	createTestPluginWithScript(t, "kn-kafka", testMetadataScript, ctx)
+
+	plugin, err := ctx.pluginManager.FindPlugin([]string{"kafka"})
+	assert.NilError(t, err)
+	metadata, err := plugin.Metadata()
+	assert.NilError(t, err)
+	assert.Equal(t, metadata.Version, "v0.2.0")
+	assert.Equal(t, metadata.MinKnVersion, "v0.16.0")
+	assert.Equal(t, len(metadata.Subcommands), 2)
+	assert.Equal(t, metadata.Subcommand([]string{"create"}).Flags[0].Name, "topic")
+	assert.Equal(t, metadata.Subcommand([]string{"describe", "deep"}).Name, "deep")
+	assert.Assert(t, metadata.Subcommand([]string{"bogus"}) == nil)
+
+	desc, err := plugin.Description()
+	assert.NilError(t, err)
+	assert.Equal(t, desc, "Manage Kafka sources")
+}
+
+func TestPluginWithoutMetadata(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts are shell scripts")
+	}
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	createTestPlugin(t, "kn-test", ctx)
+
+	plugin, err := ctx.pluginManager.FindPlugin([]string{"test"})
+	assert.NilError(t, err)
+	metadata, err := plugin.Metadata()
+	assert.NilError(t, err)
+	assert.Assert(t, metadata == nil)
+
+	desc, err := plugin.Description()
+	assert.NilError(t, err)
+	assert.Equal(t, desc, "test")
+}
+
+func TestVerifyMetadata(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts are shell scripts")
+	}
+	ctx := setup(t)
+	defer cleanup(t, ctx)
+	kafkaPath := createTestPluginWithScript(t, "kn-kafka", testMetadataScript, ctx)
+	brokenPath := createTestPluginWithScript(t, "kn-broken", "#!/bin/sh\necho '{ broken'\n", ctx)
+
+	oldVersion := version.Version
+	defer func() { version.Version = oldVersion }()
+
+	for _, data := range []struct {
+		knVersion    string
+		incompatible bool
+	}{
+		{"v0.15.0", true},
+		{"v0.16.0", false},
+		{"v20200618-local-1234", false},
+		{"", false},
+	} {
+		version.Version = data.knVersion
+		eaw := ctx.pluginManager.Verify()
+		assert.Equal(t, len(eaw.Errors), 0)
+		assert.Assert(t, util.ContainsAll(eaw.Warnings[0], brokenPath, "cannot parse metadata"))
+		if data.incompatible {
+			assert.Equal(t, len(eaw.Warnings), 2, data.knVersion)
+			assert.Assert(t, util.ContainsAll(eaw.Warnings[1], kafkaPath, "requires kn v0.16.0", data.knVersion))
+		} else {
+			assert.Equal(t, len(eaw.Warnings), 1, data.knVersion)
+		}
+	}
+}
+
+func createTestPluginWithScript(t *testing.T, name string, script string, ctx testContext) string {
+	path := filepath.Join(ctx.pluginsDir, name)
+	err := ioutil.WriteFile(path, []byte(script), 0777)
+	assert.NilError(t, err)
+	return path
+}
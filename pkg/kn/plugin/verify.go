@@ -22,6 +22,10 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+
+	"knative.dev/client/pkg/kn/commands/version"
 )
 
 // Collection of errors and warning collected during verifications
@@ -41,6 +45,8 @@ const (
 // for the verification. The following criteria are verified (for each plugin):
 // * If the plugin is executable
 // * If the plugin is overshadowed by a previous plugin
+// * If a plugin installed from an index still matches the index's checksum
+// * If the plugin's metadata can be read and the plugin is compatible with this kn version
 func (manager *Manager) Verify() VerificationErrorsAndWarnings {
 	eaw := VerificationErrorsAndWarnings{}
 
@@ -75,6 +81,72 @@ func (manager *Manager) Verify() VerificationErrorsAndWarnings {
 			eaw = verifyPath(filepath.Join(dir, f.Name()), seenPlugins, eaw)
 		}
 	}
+	eaw = manager.verifyChecksums(eaw)
+	return manager.verifyMetadata(eaw)
+}
+
+// Verify that the metadata of all plugins can be read and that the plugins
+// work with the running kn version
+func (manager *Manager) verifyMetadata(eaw VerificationErrorsAndWarnings) VerificationErrorsAndWarnings {
+	plugins, err := manager.ListPlugins()
+	if err != nil {
+		return eaw.AddError("cannot list plugins: %v", err)
+	}
+	knVersion, err := utilversion.ParseGeneric(version.Version)
+	if err != nil {
+		// Development builds don't carry a release version
+		knVersion = nil
+	}
+	for _, plugin := range plugins {
+		metadata, err := plugin.Metadata()
+		if err != nil {
+			eaw.AddWarning("%v", err)
+			continue
+		}
+		if metadata == nil || metadata.MinKnVersion == "" || knVersion == nil {
+			continue
+		}
+		minVersion, err := utilversion.ParseGeneric(metadata.MinKnVersion)
+		if err != nil {
+			eaw.AddWarning("%s declares an invalid minimal kn version '%s'", plugin.Path(), metadata.MinKnVersion)
+			continue
+		}
+		if knVersion.LessThan(minVersion) {
+			eaw.AddWarning("%s requires kn %s or newer, but this is kn %s", plugin.Path(), metadata.MinKnVersion, version.Version)
+		}
+	}
+	return eaw
+}
+
+// Verify that plugins installed from an index have not been modified since
+func (manager *Manager) verifyChecksums(eaw VerificationErrorsAndWarnings) VerificationErrorsAndWarnings {
+	installedPlugins, err := manager.InstalledPlugins()
+	if err != nil {
+		return eaw.AddError("cannot read installed plugins: %v", err)
+	}
+	dir, err := manager.expandedPluginsDir()
+	if err != nil {
+		return eaw.AddError("cannot lookup plugin directory: %v", err)
+	}
+	for _, installed := range installedPlugins {
+		path, err := findInDirOrPath("kn-"+installed.Name, dir, false)
+		if err != nil {
+			eaw.AddError("%v", err)
+			continue
+		}
+		if path == "" {
+			eaw.AddWarning("plugin '%s' %s has been installed from %s but its binary is missing", installed.Name, installed.Version, installed.Index)
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			eaw.AddError("cannot read %s: %v", path, err)
+			continue
+		}
+		if sha256Sum(data) != installed.Sha256 {
+			eaw.AddError("%s does not match the checksum of plugin '%s' %s in plugin index %s", path, installed.Name, installed.Version, installed.Index)
+		}
+	}
 	return eaw
 }
 
@@ -0,0 +1,107 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package root
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/templates"
+)
+
+// CommandFactory creates a command which is added to the kn root command.
+// The given parameters are shared with all built-in commands and carry the
+// client factories and global options.
+type CommandFactory func(p *commands.KnParams) *cobra.Command
+
+// registeredGroup is a group of commands contributed by an external package
+type registeredGroup struct {
+	header    string
+	factories []CommandFactory
+}
+
+var (
+	registry      []registeredGroup
+	registryMutex sync.Mutex
+)
+
+// RegisterCommands registers commands which are compiled into a custom kn
+// distribution. The commands are created for each root command returned by
+// NewRootCommand and listed under the given header in the help message (like
+// "Kafka Commands:"). Commands registered with the same header are grouped
+// together. Registered commands must not clash with built-in commands and are
+// subject to the same structural validation.
+//
+// RegisterCommands is typically called from an init() function of the package
+// contributing the commands.
+func RegisterCommands(header string, factories ...CommandFactory) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	for i := range registry {
+		if registry[i].header == header {
+			registry[i].factories = append(registry[i].factories, factories...)
+			return
+		}
+	}
+	registry = append(registry, registeredGroup{header: header, factories: factories})
+}
+
+// registeredCommandGroups returns the registered command groups with their
+// commands created for the given parameters
+func registeredCommandGroups(p *commands.KnParams) templates.CommandGroups {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	var groups templates.CommandGroups
+	for _, group := range registry {
+		var cmds []*cobra.Command
+		for _, factory := range group.factories {
+			cmds = append(cmds, factory(p))
+		}
+		groups = append(groups, templates.CommandGroup{Header: group.header, Commands: cmds})
+	}
+	return groups
+}
+
+// addRegisteredCommandGroups adds the registered command groups to the given
+// groups, verifying that none of the registered commands clashes with an
+// existing command
+func addRegisteredCommandGroups(groups templates.CommandGroups, p *commands.KnParams) (templates.CommandGroups, error) {
+	// Commands added outside of command groups
+	names := map[string]bool{"help": true, "options": true, "__complete": true}
+	for _, group := range groups {
+		for _, cmd := range group.Commands {
+			names[cmd.Name()] = true
+			for _, alias := range cmd.Aliases {
+				names[alias] = true
+			}
+		}
+	}
+	for _, group := range registeredCommandGroups(p) {
+		for _, cmd := range group.Commands {
+			for _, name := range append([]string{cmd.Name()}, cmd.Aliases...) {
+				if names[name] {
+					return nil, errors.Errorf("command '%s' registered for '%s' clashes with an existing command", name, strings.TrimSuffix(group.Header, ":"))
+				}
+				names[name] = true
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
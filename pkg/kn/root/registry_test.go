@@ -0,0 +1,103 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package root
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+)
+
+func TestRegisterCommands(t *testing.T) {
+	defer resetRegistry()()
+
+	var params *commands.KnParams
+	RegisterCommands("Kafka Commands:", func(p *commands.KnParams) *cobra.Command {
+		params = p
+		group := &cobra.Command{Use: "kafka", Short: "Manage Kafka"}
+		group.AddCommand(&cobra.Command{
+			Use: "list",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				_, err := p.NewServingClient("default")
+				return err
+			},
+		})
+		return group
+	})
+	RegisterCommands("Kafka Commands:", func(p *commands.KnParams) *cobra.Command {
+		return &cobra.Command{Use: "kafka-info", Short: "Show Kafka info", Run: func(cmd *cobra.Command, args []string) {}}
+	})
+
+	rootCmd, err := NewRootCommand()
+	assert.NilError(t, err)
+	assert.Assert(t, params != nil)
+	assert.Assert(t, params.NewServingClient != nil)
+
+	cmd, _, err := rootCmd.Find([]string{"kafka", "list"})
+	assert.NilError(t, err)
+	assert.Equal(t, cmd.Name(), "list")
+
+	// Command groups get the same treatment as built-in groups
+	group, _, err := rootCmd.Find([]string{"kafka"})
+	assert.NilError(t, err)
+	err = group.RunE(group, []string{})
+	assert.ErrorContains(t, err, "no sub-command given for 'kn kafka'")
+
+	out := new(bytes.Buffer)
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"--help"})
+	assert.NilError(t, rootCmd.Execute())
+	assert.Assert(t, util.ContainsAll(out.String(), "Kafka Commands:", "kafka", "Manage Kafka", "kafka-info", "Show Kafka info"))
+}
+
+func TestRegisterCommandsClash(t *testing.T) {
+	defer resetRegistry()()
+
+	RegisterCommands("Custom Commands:", func(p *commands.KnParams) *cobra.Command {
+		return &cobra.Command{Use: "custom", Aliases: []string{"revision"}, Run: func(cmd *cobra.Command, args []string) {}}
+	})
+	_, err := NewRootCommand()
+	assert.ErrorContains(t, err, "command 'revision' registered for 'Custom Commands' clashes")
+}
+
+func TestRegisterCommandsInvalidStructure(t *testing.T) {
+	defer resetRegistry()()
+
+	RegisterCommands("Custom Commands:", func(p *commands.KnParams) *cobra.Command {
+		group := &cobra.Command{Use: "custom", Run: func(cmd *cobra.Command, args []string) {}}
+		group.AddCommand(&cobra.Command{Use: "sub", Run: func(cmd *cobra.Command, args []string) {}})
+		return group
+	})
+	_, err := NewRootCommand()
+	assert.ErrorContains(t, err, "command group 'custom' must not enable any direct logic")
+}
+
+// resetRegistry clears the registry and returns a function for restoring it
+func resetRegistry() func() {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	saved := registry
+	registry = nil
+	return func() {
+		registryMutex.Lock()
+		defer registryMutex.Unlock()
+		registry = saved
+	}
+}
@@ -17,6 +17,7 @@ package root
 import (
 	"flag"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -26,17 +27,22 @@ import (
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/broker"
+	"knative.dev/client/pkg/kn/commands/channel"
 	"knative.dev/client/pkg/kn/commands/completion"
+	configcmd "knative.dev/client/pkg/kn/commands/config"
+	"knative.dev/client/pkg/kn/commands/domain"
 	"knative.dev/client/pkg/kn/commands/options"
 	"knative.dev/client/pkg/kn/commands/plugin"
 	"knative.dev/client/pkg/kn/commands/revision"
 	"knative.dev/client/pkg/kn/commands/route"
 	"knative.dev/client/pkg/kn/commands/service"
 	"knative.dev/client/pkg/kn/commands/source"
+	"knative.dev/client/pkg/kn/commands/subscription"
 	"knative.dev/client/pkg/kn/commands/trigger"
 	"knative.dev/client/pkg/kn/commands/version"
 	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/kn/flags"
+	knplugin "knative.dev/client/pkg/kn/plugin"
 	"knative.dev/client/pkg/templates"
 )
 
@@ -84,6 +90,7 @@ func NewRootCommand() (*cobra.Command, error) {
 				service.NewServiceCommand(p),
 				revision.NewRevisionCommand(p),
 				route.NewRouteCommand(p),
+				domain.NewDomainCommand(p),
 			},
 		},
 		{
@@ -92,17 +99,34 @@ func NewRootCommand() (*cobra.Command, error) {
 				source.NewSourceCommand(p),
 				broker.NewBrokerCommand(p),
 				trigger.NewTriggerCommand(p),
+				channel.NewChannelCommand(p),
+				subscription.NewSubscriptionCommand(p),
 			},
 		},
 		{
 			Header: "Other Commands:",
 			Commands: []*cobra.Command{
 				plugin.NewPluginCommand(p),
+				configcmd.NewConfigCommand(p),
 				completion.NewCompletionCommand(p),
 				version.NewVersionCommand(p),
 			},
 		},
 	}
+
+	// Commands compiled in by custom kn distributions
+	groups, err := addRegisteredCommandGroups(groups, p)
+	if err != nil {
+		return nil, err
+	}
+	groups = append(groups, templates.CommandGroup{
+		Header:       "Aliases:",
+		CommandsFunc: func() []*cobra.Command { return aliasHelpCommands(rootCmd) },
+	}, templates.CommandGroup{
+		Header:       "Plugins:",
+		CommandsFunc: func() []*cobra.Command { return pluginHelpCommands(rootCmd) },
+	})
+
 	// Add all commands to the root command, flat
 	groups.AddTo(rootCmd)
 
@@ -112,8 +136,12 @@ func NewRootCommand() (*cobra.Command, error) {
 	// Add the "options" commands for showing all global options
 	rootCmd.AddCommand(options.NewOptionsCommand())
 
+	// Add the hidden "__complete" command and hook it into the completion scripts
+	rootCmd.AddCommand(completion.NewCompleteCommand(p))
+	completion.AddDynamicCompletion(rootCmd)
+
 	// Check that command groups can't execute and that leaf commands don't h
-	err := validateCommandStructure(rootCmd)
+	err = validateCommandStructure(rootCmd)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +156,60 @@ func NewRootCommand() (*cobra.Command, error) {
 	return rootCmd, nil
 }
 
+// pluginHelpCommands returns an entry for each top-level plugin for listing it
+// in the help message. These commands are never executed, plugins are dispatched
+// before the root command is run.
+func pluginHelpCommands(rootCmd *cobra.Command) []*cobra.Command {
+	manager := knplugin.NewManager(config.GlobalConfig.PluginsDir(), config.GlobalConfig.LookupPluginsInPath())
+	plugins, err := manager.ListPlugins()
+	if err != nil {
+		return nil
+	}
+	var cmds []*cobra.Command
+	for _, pl := range plugins {
+		parts := pl.CommandParts()
+		if len(parts) != 1 {
+			// Plugins extending a command group are not shown on the top-level
+			continue
+		}
+		if cmd, _, err := rootCmd.Find(parts); err == nil && cmd != rootCmd {
+			// Built-in commands can't be overridden
+			continue
+		}
+		desc, _ := pl.Description()
+		cmds = append(cmds, &cobra.Command{
+			Use:   parts[0],
+			Short: desc,
+			Run:   func(cmd *cobra.Command, args []string) {},
+		})
+	}
+	return cmds
+}
+
+// aliasHelpCommands returns an entry for each alias from the configuration for listing
+// it in the help message. Aliases are expanded before the root command is run.
+func aliasHelpCommands(rootCmd *cobra.Command) []*cobra.Command {
+	aliases := config.GlobalConfig.Aliases()
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		if cmd, _, err := rootCmd.Find([]string{name}); err == nil && cmd != rootCmd {
+			// Built-in commands can't be overridden
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var cmds []*cobra.Command
+	for _, name := range names {
+		cmds = append(cmds, &cobra.Command{
+			Use:   name,
+			Short: fmt.Sprintf("Alias for '%s'", strings.Join(aliases[name], " ")),
+			Run:   func(cmd *cobra.Command, args []string) {},
+		})
+	}
+	return cmds
+}
+
 // Verify that command groups are not executable and that leaf commands have a run function
 func validateCommandStructure(cmd *cobra.Command) error {
 	for _, childCmd := range cmd.Commands() {
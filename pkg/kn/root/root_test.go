@@ -15,13 +15,19 @@
 package root
 
 import (
+	"bytes"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"gotest.tools/assert"
 
+	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/util"
 )
 
@@ -69,6 +75,60 @@ func TestCommandGroup(t *testing.T) {
 	}
 }
 
+func TestPluginsInHelp(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts are shell scripts")
+	}
+	pluginsDir, err := ioutil.TempDir("", "kn-plugins")
+	assert.NilError(t, err)
+	defer os.RemoveAll(pluginsDir)
+	for name, script := range map[string]string{
+		"kn-hello":       "#!/bin/sh\necho '{\"description\": \"Say hello\"}'\n",
+		"kn-service":     "#!/bin/sh\n",
+		"kn-source-test": "#!/bin/sh\n",
+	} {
+		assert.NilError(t, ioutil.WriteFile(filepath.Join(pluginsDir, name), []byte(script), 0700))
+	}
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestPluginsDir: pluginsDir}
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	rootCmd, err := NewRootCommand()
+	assert.NilError(t, err)
+	cmds := pluginHelpCommands(rootCmd)
+	assert.Equal(t, len(cmds), 1)
+	assert.Equal(t, cmds[0].Name(), "hello")
+	assert.Equal(t, cmds[0].Short, "Say hello")
+
+	out := new(bytes.Buffer)
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"--help"})
+	assert.NilError(t, rootCmd.Execute())
+	assert.Assert(t, util.ContainsAll(out.String(), "Plugins:", "hello", "Say hello"))
+}
+
+func TestAliasesInHelp(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestAliases: map[string][]string{
+		"deploy":  {"service", "update", "--no-wait"},
+		"service": {"version"},
+	}}
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	rootCmd, err := NewRootCommand()
+	assert.NilError(t, err)
+	cmds := aliasHelpCommands(rootCmd)
+	assert.Equal(t, len(cmds), 1)
+	assert.Equal(t, cmds[0].Name(), "deploy")
+	assert.Equal(t, cmds[0].Short, "Alias for 'service update --no-wait'")
+
+	out := new(bytes.Buffer)
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"--help"})
+	assert.NilError(t, rootCmd.Execute())
+	assert.Assert(t, util.ContainsAll(out.String(), "Aliases:", "deploy", "Alias for 'service update --no-wait'"))
+}
+
 func TestEmptyAndUnknownSubCommands(t *testing.T) {
 	rootCmd := &cobra.Command{
 		Use: "root",
@@ -0,0 +1,56 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"fmt"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// ResolveRevision returns the name of the revision referenced either by the
+// given revision reference or by the given tag. The revision reference is a
+// revision name or '@latest' for the latest ready revision of the service.
+// The tag is looked up in the traffic targets of the service's status. An
+// empty string is returned if neither a revision nor a tag is given.
+func ResolveRevision(service *servingv1.Service, revisionRef, tag string) (string, error) {
+	if revisionRef != "" && tag != "" {
+		return "", fmt.Errorf("only one of a revision or a tag can be given")
+	}
+	if revisionRef == latestRevisionRef {
+		if service.Status.LatestReadyRevisionName == "" {
+			return "", fmt.Errorf("service '%s' has no ready revision", service.Name)
+		}
+		return service.Status.LatestReadyRevisionName, nil
+	}
+	if revisionRef != "" {
+		return revisionRef, nil
+	}
+	if tag == "" {
+		return "", nil
+	}
+	for _, target := range service.Status.Traffic {
+		if target.Tag != tag {
+			continue
+		}
+		if target.RevisionName != "" {
+			return target.RevisionName, nil
+		}
+		if target.LatestRevision != nil && *target.LatestRevision {
+			return service.Status.LatestReadyRevisionName, nil
+		}
+	}
+	return "", fmt.Errorf("tag '%s' not found in traffic of service '%s'", tag, service.Name)
+}
@@ -0,0 +1,57 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestResolveRevision(t *testing.T) {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+	service.Status.LatestReadyRevisionName = "foo-v3"
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{Tag: "current", RevisionName: "foo-v2", Percent: ptr.Int64(100)},
+		{Tag: "candidate", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(0)},
+	}
+
+	for _, tc := range []struct {
+		revision, tag, expected, err string
+	}{
+		{"", "", "", ""},
+		{"foo-v1", "", "foo-v1", ""},
+		{"@latest", "", "foo-v3", ""},
+		{"", "current", "foo-v2", ""},
+		{"", "candidate", "foo-v3", ""},
+		{"", "unknown", "", "tag 'unknown' not found"},
+		{"foo-v1", "current", "", "only one of"},
+	} {
+		revision, err := ResolveRevision(service, tc.revision, tc.tag)
+		if tc.err != "" {
+			assert.ErrorContains(t, err, tc.err)
+			continue
+		}
+		assert.NilError(t, err)
+		assert.Equal(t, revision, tc.expected)
+	}
+
+	service.Status.LatestReadyRevisionName = ""
+	_, err := ResolveRevision(service, "@latest", "")
+	assert.ErrorContains(t, err, "no ready revision")
+}
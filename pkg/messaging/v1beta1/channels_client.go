@@ -0,0 +1,162 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
+	clientv1beta1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/messaging/v1beta1"
+
+	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/util"
+)
+
+// KnChannelsClient for interacting with Channels
+type KnChannelsClient interface {
+
+	// GetChannel returns a Channel by its name
+	GetChannel(name string) (*v1beta1.Channel, error)
+
+	// CreateChannel creates a Channel
+	CreateChannel(channel *v1beta1.Channel) error
+
+	// DeleteChannel deletes a Channel by its name
+	DeleteChannel(name string) error
+
+	// ListChannel lists all Channels
+	ListChannel() (*v1beta1.ChannelList, error)
+
+	// Namespace in which this client is operating for
+	Namespace() string
+}
+
+// channelsClient is a combination of the Channel client interface and namespace
+type channelsClient struct {
+	client    clientv1beta1.ChannelInterface
+	namespace string
+}
+
+// newKnChannelsClient is to invoke Eventing Messaging Client API to create object
+func newKnChannelsClient(client clientv1beta1.ChannelInterface, namespace string) KnChannelsClient {
+	return &channelsClient{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// Get the namespace for which this client has been created
+func (c *channelsClient) Namespace() string {
+	return c.namespace
+}
+
+// GetChannel returns a Channel by its name
+func (c *channelsClient) GetChannel(name string) (*v1beta1.Channel, error) {
+	channel, err := c.client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	err = updateMessagingGVK(channel)
+	if err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+// CreateChannel creates a Channel
+func (c *channelsClient) CreateChannel(channel *v1beta1.Channel) error {
+	_, err := c.client.Create(channel)
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// DeleteChannel deletes a Channel by its name
+func (c *channelsClient) DeleteChannel(name string) error {
+	err := c.client.Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// ListChannel returns the available Channels
+func (c *channelsClient) ListChannel() (*v1beta1.ChannelList, error) {
+	channelList, err := c.client.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	channelListNew := channelList.DeepCopy()
+	err = updateMessagingGVK(channelListNew)
+	if err != nil {
+		return nil, err
+	}
+
+	channelListNew.Items = make([]v1beta1.Channel, len(channelList.Items))
+	for idx, channel := range channelList.Items {
+		channelClone := channel.DeepCopy()
+		err := updateMessagingGVK(channelClone)
+		if err != nil {
+			return nil, err
+		}
+		channelListNew.Items[idx] = *channelClone
+	}
+	return channelListNew, nil
+}
+
+// update with the v1beta1 group + version
+func updateMessagingGVK(obj runtime.Object) error {
+	return util.UpdateGroupVersionKindWithScheme(obj, v1beta1.SchemeGroupVersion, scheme.Scheme)
+}
+
+// ChannelBuilder is for building the Channel
+type ChannelBuilder struct {
+	channel *v1beta1.Channel
+}
+
+// NewChannelBuilder for building Channel object
+func NewChannelBuilder(name string) *ChannelBuilder {
+	return &ChannelBuilder{channel: &v1beta1.Channel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}}
+}
+
+// Namespace for this Channel
+func (b *ChannelBuilder) Namespace(ns string) *ChannelBuilder {
+	b.channel.Namespace = ns
+	return b
+}
+
+// Type sets the channel CRD backing the Channel. If not set, the cluster's
+// default channel type is used
+func (b *ChannelBuilder) Type(gvk *metav1.TypeMeta) *ChannelBuilder {
+	if gvk == nil {
+		b.channel.Spec.ChannelTemplate = nil
+		return b
+	}
+	b.channel.Spec.ChannelTemplate = &v1beta1.ChannelTemplateSpec{
+		TypeMeta: *gvk,
+	}
+	return b
+}
+
+// Build to return an instance of Channel object
+func (b *ChannelBuilder) Build() *v1beta1.Channel {
+	return b.channel
+}
@@ -0,0 +1,127 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing/pkg/client/clientset/versioned/typed/messaging/v1beta1/fake"
+)
+
+var testNamespace = "test-ns"
+
+func setupChannelsClient(t *testing.T) (fakeMessaging fake.FakeMessagingV1beta1, client KnChannelsClient) {
+	fakeMessaging = fake.FakeMessagingV1beta1{Fake: &clienttesting.Fake{}}
+	client = NewKnMessagingClient(&fakeMessaging, testNamespace).ChannelsClient()
+	assert.Equal(t, client.Namespace(), testNamespace)
+	return
+}
+
+func TestCreateChannel(t *testing.T) {
+	server, client := setupChannelsClient(t)
+
+	server.AddReactor("create", "channels",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			newChannel := a.(clienttesting.CreateAction).GetObject()
+			name := newChannel.(metav1.Object).GetName()
+			if name == "errorChannel" {
+				return true, nil, fmt.Errorf("error while creating channel %s", name)
+			}
+			return true, newChannel, nil
+		})
+
+	err := client.CreateChannel(newChannel("c1"))
+	assert.NilError(t, err)
+
+	err = client.CreateChannel(newChannel("errorChannel"))
+	assert.ErrorContains(t, err, "errorChannel")
+}
+
+func TestGetChannel(t *testing.T) {
+	server, client := setupChannelsClient(t)
+
+	server.AddReactor("get", "channels",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.GetAction).GetName()
+			if name == "errorChannel" {
+				return true, nil, fmt.Errorf("error while getting channel %s", name)
+			}
+			return true, newChannel(name), nil
+		})
+
+	channel, err := client.GetChannel("c1")
+	assert.NilError(t, err)
+	assert.Equal(t, channel.Name, "c1")
+	assert.Equal(t, channel.Kind, "Channel")
+
+	_, err = client.GetChannel("errorChannel")
+	assert.ErrorContains(t, err, "errorChannel")
+}
+
+func TestDeleteChannel(t *testing.T) {
+	server, client := setupChannelsClient(t)
+
+	server.AddReactor("delete", "channels",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.DeleteAction).GetName()
+			if name == "errorChannel" {
+				return true, nil, fmt.Errorf("error while deleting channel %s", name)
+			}
+			return true, nil, nil
+		})
+
+	err := client.DeleteChannel("c1")
+	assert.NilError(t, err)
+
+	err = client.DeleteChannel("errorChannel")
+	assert.ErrorContains(t, err, "errorChannel")
+}
+
+func TestListChannel(t *testing.T) {
+	server, client := setupChannelsClient(t)
+
+	server.AddReactor("list", "channels",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &v1beta1.ChannelList{Items: []v1beta1.Channel{*newChannel("c1"), *newChannel("c2")}}, nil
+		})
+
+	channelList, err := client.ListChannel()
+	assert.NilError(t, err)
+	assert.Equal(t, channelList.Kind, "ChannelList")
+	assert.Equal(t, len(channelList.Items), 2)
+	assert.Equal(t, channelList.Items[0].Name, "c1")
+	assert.Equal(t, channelList.Items[1].Kind, "Channel")
+}
+
+func TestChannelBuilder(t *testing.T) {
+	gvk := &metav1.TypeMeta{APIVersion: "messaging.knative.dev/v1beta1", Kind: "InMemoryChannel"}
+	channel := NewChannelBuilder("c1").Namespace("ns").Type(gvk).Build()
+	assert.Equal(t, channel.Name, "c1")
+	assert.Equal(t, channel.Namespace, "ns")
+	assert.Equal(t, channel.Spec.ChannelTemplate.Kind, "InMemoryChannel")
+
+	channel = NewChannelBuilder("c1").Type(nil).Build()
+	assert.Assert(t, channel.Spec.ChannelTemplate == nil)
+}
+
+func newChannel(name string) *v1beta1.Channel {
+	return NewChannelBuilder(name).Namespace(testNamespace).Build()
+}
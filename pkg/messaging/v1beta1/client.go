@@ -0,0 +1,53 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	clientv1beta1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/messaging/v1beta1"
+)
+
+// KnMessagingClient to Eventing Messaging. All methods are relative to the
+// namespace specified during construction
+type KnMessagingClient interface {
+	// Get the client for Channels
+	ChannelsClient() KnChannelsClient
+
+	// Get the client for Subscriptions
+	SubscriptionsClient() KnSubscriptionsClient
+}
+
+// messagingClient is a combination of the messaging client interface and namespace
+type messagingClient struct {
+	client    clientv1beta1.MessagingV1beta1Interface
+	namespace string
+}
+
+// NewKnMessagingClient for managing all eventing messaging types
+func NewKnMessagingClient(client clientv1beta1.MessagingV1beta1Interface, namespace string) KnMessagingClient {
+	return &messagingClient{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// ChannelsClient for dealing with Channels
+func (c *messagingClient) ChannelsClient() KnChannelsClient {
+	return newKnChannelsClient(c.client.Channels(c.namespace), c.namespace)
+}
+
+// SubscriptionsClient for dealing with Subscriptions
+func (c *messagingClient) SubscriptionsClient() KnSubscriptionsClient {
+	return newKnSubscriptionsClient(c.client.Subscriptions(c.namespace), c.namespace)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+)
+
+// MockKnMessagingClient returns mock clients for all messaging types
+type MockKnMessagingClient struct {
+	channelsClient      *MockKnChannelsClient
+	subscriptionsClient *MockKnSubscriptionsClient
+}
+
+// NewMockKnMessagingClient returns a new mock instance whose type specific clients need to be recorded for
+func NewMockKnMessagingClient(t *testing.T, ns ...string) *MockKnMessagingClient {
+	return &MockKnMessagingClient{
+		channelsClient:      NewMockKnChannelsClient(t, ns...),
+		subscriptionsClient: NewMockKnSubscriptionsClient(t, ns...),
+	}
+}
+
+// Ensure that the interface is implemented
+var _ KnMessagingClient = &MockKnMessagingClient{}
+
+// ChannelsClient returns the mock client for Channels
+func (c *MockKnMessagingClient) ChannelsClient() KnChannelsClient {
+	return c.channelsClient
+}
+
+// ChannelsRecorder returns the recorder of the mock Channels client
+func (c *MockKnMessagingClient) ChannelsRecorder() *ChannelsRecorder {
+	return c.channelsClient.Recorder()
+}
+
+// SubscriptionsClient returns the mock client for Subscriptions
+func (c *MockKnMessagingClient) SubscriptionsClient() KnSubscriptionsClient {
+	return c.subscriptionsClient
+}
+
+// SubscriptionsRecorder returns the recorder of the mock Subscriptions client
+func (c *MockKnMessagingClient) SubscriptionsRecorder() *SubscriptionsRecorder {
+	return c.subscriptionsClient.Recorder()
+}
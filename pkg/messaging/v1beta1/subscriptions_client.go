@@ -0,0 +1,180 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+	clientv1beta1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/messaging/v1beta1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	knerrors "knative.dev/client/pkg/errors"
+)
+
+// KnSubscriptionsClient for interacting with Subscriptions
+type KnSubscriptionsClient interface {
+
+	// GetSubscription returns a Subscription by its name
+	GetSubscription(name string) (*v1beta1.Subscription, error)
+
+	// CreateSubscription creates a Subscription
+	CreateSubscription(subscription *v1beta1.Subscription) error
+
+	// DeleteSubscription deletes a Subscription by its name
+	DeleteSubscription(name string) error
+
+	// ListSubscription lists all Subscriptions
+	ListSubscription() (*v1beta1.SubscriptionList, error)
+
+	// Namespace in which this client is operating for
+	Namespace() string
+}
+
+// subscriptionsClient is a combination of the Subscription client interface and namespace
+type subscriptionsClient struct {
+	client    clientv1beta1.SubscriptionInterface
+	namespace string
+}
+
+// newKnSubscriptionsClient is to invoke Eventing Messaging Client API to create object
+func newKnSubscriptionsClient(client clientv1beta1.SubscriptionInterface, namespace string) KnSubscriptionsClient {
+	return &subscriptionsClient{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// Get the namespace for which this client has been created
+func (c *subscriptionsClient) Namespace() string {
+	return c.namespace
+}
+
+// GetSubscription returns a Subscription by its name
+func (c *subscriptionsClient) GetSubscription(name string) (*v1beta1.Subscription, error) {
+	subscription, err := c.client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	err = updateMessagingGVK(subscription)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// CreateSubscription creates a Subscription
+func (c *subscriptionsClient) CreateSubscription(subscription *v1beta1.Subscription) error {
+	_, err := c.client.Create(subscription)
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// DeleteSubscription deletes a Subscription by its name
+func (c *subscriptionsClient) DeleteSubscription(name string) error {
+	err := c.client.Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// ListSubscription returns the available Subscriptions
+func (c *subscriptionsClient) ListSubscription() (*v1beta1.SubscriptionList, error) {
+	subscriptionList, err := c.client.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	subscriptionListNew := subscriptionList.DeepCopy()
+	err = updateMessagingGVK(subscriptionListNew)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionListNew.Items = make([]v1beta1.Subscription, len(subscriptionList.Items))
+	for idx, subscription := range subscriptionList.Items {
+		subscriptionClone := subscription.DeepCopy()
+		err := updateMessagingGVK(subscriptionClone)
+		if err != nil {
+			return nil, err
+		}
+		subscriptionListNew.Items[idx] = *subscriptionClone
+	}
+	return subscriptionListNew, nil
+}
+
+// SubscriptionBuilder is for building the Subscription
+type SubscriptionBuilder struct {
+	subscription *v1beta1.Subscription
+}
+
+// NewSubscriptionBuilder for building Subscription object
+func NewSubscriptionBuilder(name string) *SubscriptionBuilder {
+	return &SubscriptionBuilder{subscription: &v1beta1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}}
+}
+
+// Namespace for this Subscription
+func (b *SubscriptionBuilder) Namespace(ns string) *SubscriptionBuilder {
+	b.subscription.Namespace = ns
+	return b
+}
+
+// Channel the Subscription is subscribed to
+func (b *SubscriptionBuilder) Channel(channel *corev1.ObjectReference) *SubscriptionBuilder {
+	if channel == nil {
+		return b
+	}
+	b.subscription.Spec.Channel = *channel
+	return b
+}
+
+// Subscriber for the Subscription to send events to
+func (b *SubscriptionBuilder) Subscriber(subscriber *duckv1.Destination) *SubscriptionBuilder {
+	b.subscription.Spec.Subscriber = subscriber
+	return b
+}
+
+// Reply to send the replies of the subscriber to
+func (b *SubscriptionBuilder) Reply(reply *duckv1.Destination) *SubscriptionBuilder {
+	b.subscription.Spec.Reply = reply
+	return b
+}
+
+// DeadLetterSink to send events to which could not be delivered
+func (b *SubscriptionBuilder) DeadLetterSink(sink *duckv1.Destination) *SubscriptionBuilder {
+	if sink == nil {
+		if b.subscription.Spec.Delivery != nil {
+			b.subscription.Spec.Delivery.DeadLetterSink = nil
+		}
+		return b
+	}
+	if b.subscription.Spec.Delivery == nil {
+		b.subscription.Spec.Delivery = &eventingduckv1beta1.DeliverySpec{}
+	}
+	b.subscription.Spec.Delivery.DeadLetterSink = sink
+	return b
+}
+
+// Build to return an instance of Subscription object
+func (b *SubscriptionBuilder) Build() *v1beta1.Subscription {
+	return b.subscription
+}
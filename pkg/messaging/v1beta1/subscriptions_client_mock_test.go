@@ -0,0 +1,43 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+)
+
+func TestMockKnSubscriptionsClient(t *testing.T) {
+	client := NewMockKnMessagingClient(t)
+
+	recorder := client.SubscriptionsRecorder()
+
+	// Record all calls
+	recorder.GetSubscription("hello", nil, nil)
+	recorder.CreateSubscription(&v1beta1.Subscription{}, nil)
+	recorder.DeleteSubscription("hello", nil)
+	recorder.ListSubscription(nil, nil)
+
+	// Call all methods
+	subscriptionsClient := client.SubscriptionsClient()
+	subscriptionsClient.GetSubscription("hello")
+	subscriptionsClient.CreateSubscription(&v1beta1.Subscription{})
+	subscriptionsClient.DeleteSubscription("hello")
+	subscriptionsClient.ListSubscription()
+
+	// Validate
+	recorder.Validate()
+}
@@ -0,0 +1,138 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/eventing/pkg/apis/messaging/v1beta1"
+	"knative.dev/eventing/pkg/client/clientset/versioned/typed/messaging/v1beta1/fake"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func setupSubscriptionsClient(t *testing.T) (fakeMessaging fake.FakeMessagingV1beta1, client KnSubscriptionsClient) {
+	fakeMessaging = fake.FakeMessagingV1beta1{Fake: &clienttesting.Fake{}}
+	client = NewKnMessagingClient(&fakeMessaging, testNamespace).SubscriptionsClient()
+	assert.Equal(t, client.Namespace(), testNamespace)
+	return
+}
+
+func TestCreateSubscription(t *testing.T) {
+	server, client := setupSubscriptionsClient(t)
+
+	server.AddReactor("create", "subscriptions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			newSubscription := a.(clienttesting.CreateAction).GetObject()
+			name := newSubscription.(metav1.Object).GetName()
+			if name == "errorSubscription" {
+				return true, nil, fmt.Errorf("error while creating subscription %s", name)
+			}
+			return true, newSubscription, nil
+		})
+
+	err := client.CreateSubscription(newSubscription("s1"))
+	assert.NilError(t, err)
+
+	err = client.CreateSubscription(newSubscription("errorSubscription"))
+	assert.ErrorContains(t, err, "errorSubscription")
+}
+
+func TestGetSubscription(t *testing.T) {
+	server, client := setupSubscriptionsClient(t)
+
+	server.AddReactor("get", "subscriptions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.GetAction).GetName()
+			if name == "errorSubscription" {
+				return true, nil, fmt.Errorf("error while getting subscription %s", name)
+			}
+			return true, newSubscription(name), nil
+		})
+
+	subscription, err := client.GetSubscription("s1")
+	assert.NilError(t, err)
+	assert.Equal(t, subscription.Name, "s1")
+	assert.Equal(t, subscription.Kind, "Subscription")
+
+	_, err = client.GetSubscription("errorSubscription")
+	assert.ErrorContains(t, err, "errorSubscription")
+}
+
+func TestDeleteSubscription(t *testing.T) {
+	server, client := setupSubscriptionsClient(t)
+
+	server.AddReactor("delete", "subscriptions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.DeleteAction).GetName()
+			if name == "errorSubscription" {
+				return true, nil, fmt.Errorf("error while deleting subscription %s", name)
+			}
+			return true, nil, nil
+		})
+
+	err := client.DeleteSubscription("s1")
+	assert.NilError(t, err)
+
+	err = client.DeleteSubscription("errorSubscription")
+	assert.ErrorContains(t, err, "errorSubscription")
+}
+
+func TestListSubscription(t *testing.T) {
+	server, client := setupSubscriptionsClient(t)
+
+	server.AddReactor("list", "subscriptions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &v1beta1.SubscriptionList{Items: []v1beta1.Subscription{*newSubscription("s1"), *newSubscription("s2")}}, nil
+		})
+
+	subscriptionList, err := client.ListSubscription()
+	assert.NilError(t, err)
+	assert.Equal(t, subscriptionList.Kind, "SubscriptionList")
+	assert.Equal(t, len(subscriptionList.Items), 2)
+	assert.Equal(t, subscriptionList.Items[0].Name, "s1")
+	assert.Equal(t, subscriptionList.Items[1].Kind, "Subscription")
+}
+
+func TestSubscriptionBuilder(t *testing.T) {
+	channel := &corev1.ObjectReference{APIVersion: "messaging.knative.dev/v1beta1", Kind: "Channel", Name: "pipe"}
+	sink := &duckv1.Destination{Ref: &duckv1.KReference{APIVersion: "serving.knative.dev/v1", Kind: "Service", Name: "foo"}}
+	dls := &duckv1.Destination{Ref: &duckv1.KReference{APIVersion: "serving.knative.dev/v1", Kind: "Service", Name: "dlq"}}
+	subscription := NewSubscriptionBuilder("s1").
+		Namespace("ns").
+		Channel(channel).
+		Subscriber(sink).
+		Reply(nil).
+		DeadLetterSink(dls).
+		Build()
+	assert.Equal(t, subscription.Name, "s1")
+	assert.Equal(t, subscription.Namespace, "ns")
+	assert.DeepEqual(t, subscription.Spec.Channel, *channel)
+	assert.DeepEqual(t, subscription.Spec.Subscriber, sink)
+	assert.Assert(t, subscription.Spec.Reply == nil)
+	assert.DeepEqual(t, subscription.Spec.Delivery.DeadLetterSink, dls)
+
+	subscription = NewSubscriptionBuilder("s1").DeadLetterSink(nil).Build()
+	assert.Assert(t, subscription.Spec.Delivery == nil)
+}
+
+func newSubscription(name string) *v1beta1.Subscription {
+	return NewSubscriptionBuilder(name).Namespace(testNamespace).Build()
+}
@@ -0,0 +1,98 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package negotiation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// conversionKey identifies the conversion of a kind between kn's version and
+// another version
+type conversionKey struct {
+	group   string
+	kind    string
+	version string
+}
+
+// conversion holds the functions converting the fields of an object which
+// differ between kn's version and another version
+type conversion struct {
+	toServed   func(obj map[string]interface{})
+	toInternal func(obj map[string]interface{})
+}
+
+// conversions for kinds whose fields differ, all other kinds only get their
+// apiVersion changed
+var conversions = map[conversionKey]conversion{
+	{group: "sources.knative.dev", kind: "PingSource", version: "v1beta2"}: pingSourceDataConversion,
+	{group: "sources.knative.dev", kind: "PingSource", version: "v1"}:      pingSourceDataConversion,
+}
+
+// pingSourceDataConversion maps 'jsonData' to 'data' with a JSON content type
+var pingSourceDataConversion = conversion{
+	toServed: func(obj map[string]interface{}) {
+		data, found, _ := unstructured.NestedString(obj, "spec", "jsonData")
+		if !found {
+			return
+		}
+		unstructured.RemoveNestedField(obj, "spec", "jsonData")
+		unstructured.SetNestedField(obj, data, "spec", "data")
+		unstructured.SetNestedField(obj, "application/json", "spec", "contentType")
+	},
+	toInternal: func(obj map[string]interface{}) {
+		data, found, _ := unstructured.NestedString(obj, "spec", "data")
+		if !found {
+			return
+		}
+		unstructured.RemoveNestedField(obj, "spec", "data")
+		unstructured.RemoveNestedField(obj, "spec", "contentType")
+		unstructured.SetNestedField(obj, data, "spec", "jsonData")
+	},
+}
+
+// convertObject converts an object or a list of objects of one version to the
+// other version. It returns false if the object isn't of the given version.
+func convertObject(obj map[string]interface{}, from schema.GroupVersion, to schema.GroupVersion) bool {
+	if obj["apiVersion"] != from.String() {
+		return false
+	}
+	obj["apiVersion"] = to.String()
+	kind, _ := obj["kind"].(string)
+	if items, ok := obj["items"].([]interface{}); ok {
+		for _, item := range items {
+			if itemObj, ok := item.(map[string]interface{}); ok {
+				// Items of lists might not carry their type
+				if _, ok := itemObj["apiVersion"]; !ok {
+					itemObj["apiVersion"] = from.String()
+				}
+				if _, ok := itemObj["kind"]; !ok {
+					itemObj["kind"] = strings.TrimSuffix(kind, "List")
+				}
+				convertObject(itemObj, from, to)
+			}
+		}
+		return true
+	}
+
+	if c, ok := conversions[conversionKey{group: from.Group, kind: kind, version: to.Version}]; ok {
+		c.toServed(obj)
+	} else if c, ok := conversions[conversionKey{group: from.Group, kind: kind, version: from.Version}]; ok {
+		c.toInternal(obj)
+	}
+	return true
+}
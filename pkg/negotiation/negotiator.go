@@ -0,0 +1,109 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package negotiation
+
+import (
+	"fmt"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// compatibleVersions lists for each API version kn has been built against the
+// other versions of the same group which can be converted to and from it, in
+// order of preference
+var compatibleVersions = map[schema.GroupVersion][]string{
+	{Group: "eventing.knative.dev", Version: "v1beta1"}:  {"v1"},
+	{Group: "messaging.knative.dev", Version: "v1beta1"}: {"v1"},
+	{Group: "sources.knative.dev", Version: "v1alpha2"}:  {"v1beta1", "v1beta2", "v1"},
+}
+
+// Negotiator picks the version to use for a resource of an API version kn has
+// been built against, based on the versions served by the cluster
+type Negotiator struct {
+	discovery discovery.DiscoveryInterface
+
+	mutex sync.Mutex
+	// resources served per group version, nil if the group version is not served
+	served map[schema.GroupVersion]map[string]bool
+	// negotiated versions per group version and resource
+	negotiated map[schema.GroupVersionResource]schema.GroupVersion
+}
+
+// NewNegotiator creates a negotiator which uses the given discovery client
+// for looking up the served versions
+func NewNegotiator(discovery discovery.DiscoveryInterface) *Negotiator {
+	return &Negotiator{
+		discovery:  discovery,
+		served:     map[schema.GroupVersion]map[string]bool{},
+		negotiated: map[schema.GroupVersionResource]schema.GroupVersion{},
+	}
+}
+
+// Negotiate returns the version to use for the given resource. This is the
+// given version itself if the cluster serves it. Otherwise it is the first
+// compatible version served by the cluster. The discovery results are cached
+// for the lifetime of the negotiator.
+func (n *Negotiator) Negotiate(gvr schema.GroupVersionResource) (schema.GroupVersion, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if gv, ok := n.negotiated[gvr]; ok {
+		return gv, nil
+	}
+	candidates := []schema.GroupVersion{gvr.GroupVersion()}
+	for _, version := range compatibleVersions[gvr.GroupVersion()] {
+		candidates = append(candidates, schema.GroupVersion{Group: gvr.Group, Version: version})
+	}
+	for _, candidate := range candidates {
+		served, err := n.servedResources(candidate)
+		if err != nil {
+			return schema.GroupVersion{}, err
+		}
+		if served[gvr.Resource] {
+			n.negotiated[gvr] = candidate
+			return candidate, nil
+		}
+	}
+	return schema.GroupVersion{}, fmt.Errorf("no version of resource '%s' in API group '%s' supported by this client is served by the cluster", gvr.Resource, gvr.Group)
+}
+
+// IsNegotiable returns true if versions compatible to the given version are known
+func IsNegotiable(gv schema.GroupVersion) bool {
+	_, ok := compatibleVersions[gv]
+	return ok
+}
+
+func (n *Negotiator) servedResources(gv schema.GroupVersion) (map[string]bool, error) {
+	if served, ok := n.served[gv]; ok {
+		return served, nil
+	}
+	list, err := n.discovery.ServerResourcesForGroupVersion(gv.String())
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		n.served[gv] = nil
+		return nil, nil
+	}
+	served := map[string]bool{}
+	for _, resource := range list.APIResources {
+		served[resource.Name] = true
+	}
+	n.served[gv] = served
+	return served, nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package negotiation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+func TestNegotiate(t *testing.T) {
+	server := newDiscoveryServer(map[string][]string{
+		"sources.knative.dev/v1alpha2":  {"sinkbindings"},
+		"sources.knative.dev/v1beta1":   {"apiserversources", "sinkbindings"},
+		"sources.knative.dev/v1beta2":   {"pingsources"},
+		"eventing.knative.dev/v1beta1":  {"brokers", "triggers"},
+		"messaging.knative.dev/v1beta1": {},
+	})
+	defer server.Close()
+	negotiator := newTestNegotiator(t, server)
+
+	for _, tc := range []struct {
+		gvr      schema.GroupVersionResource
+		expected string
+	}{
+		{gvr("sources.knative.dev/v1alpha2", "sinkbindings"), "sources.knative.dev/v1alpha2"},
+		{gvr("sources.knative.dev/v1alpha2", "apiserversources"), "sources.knative.dev/v1beta1"},
+		{gvr("sources.knative.dev/v1alpha2", "pingsources"), "sources.knative.dev/v1beta2"},
+		{gvr("eventing.knative.dev/v1beta1", "triggers"), "eventing.knative.dev/v1beta1"},
+	} {
+		gv, err := negotiator.Negotiate(tc.gvr)
+		assert.NilError(t, err)
+		assert.Equal(t, gv.String(), tc.expected)
+	}
+
+	_, err := negotiator.Negotiate(gvr("messaging.knative.dev/v1beta1", "channels"))
+	assert.ErrorContains(t, err, "no version of resource 'channels'")
+
+	// Discovery results are cached
+	server.Close()
+	gv, err := negotiator.Negotiate(gvr("sources.knative.dev/v1alpha2", "apiserversources"))
+	assert.NilError(t, err)
+	assert.Equal(t, gv.String(), "sources.knative.dev/v1beta1")
+}
+
+func TestIsNegotiable(t *testing.T) {
+	assert.Assert(t, IsNegotiable(schema.GroupVersion{Group: "sources.knative.dev", Version: "v1alpha2"}))
+	assert.Assert(t, !IsNegotiable(schema.GroupVersion{Group: "serving.knative.dev", Version: "v1"}))
+}
+
+func newTestNegotiator(t *testing.T, server *httptest.Server) *Negotiator {
+	client, err := discovery.NewDiscoveryClientForConfig(&rest.Config{Host: server.URL})
+	assert.NilError(t, err)
+	return NewNegotiator(client)
+}
+
+// newDiscoveryServer serves the resources of the given group versions for
+// discovery. Other requests are passed on to the given handlers.
+func newDiscoveryServer(resources map[string][]string, handlers ...http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for gv, names := range resources {
+			if r.URL.Path == "/apis/"+gv {
+				list := metav1.APIResourceList{GroupVersion: gv}
+				for _, name := range names {
+					list.APIResources = append(list.APIResources, metav1.APIResource{Name: name, Namespaced: true})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(list)
+				return
+			}
+		}
+		for _, handler := range handlers {
+			handler(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+}
+
+func gvr(groupVersion string, resource string) schema.GroupVersionResource {
+	gv, _ := schema.ParseGroupVersion(groupVersion)
+	return gv.WithResource(resource)
+}
@@ -0,0 +1,190 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package negotiation
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/transport"
+)
+
+// NewTransportWrapper returns a wrapper for REST transports which sends
+// requests for API versions kn has been built against to the versions
+// negotiated with the cluster, converting the objects in the request and
+// response bodies
+func NewTransportWrapper(negotiator *Negotiator) transport.WrapperFunc {
+	return func(delegate http.RoundTripper) http.RoundTripper {
+		return &negotiatingTransport{negotiator: negotiator, delegate: delegate}
+	}
+}
+
+type negotiatingTransport struct {
+	negotiator *Negotiator
+	delegate   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *negotiatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	gvr, ok := parseResourcePath(req.URL.Path)
+	if !ok || !IsNegotiable(gvr.GroupVersion()) {
+		return t.delegate.RoundTrip(req)
+	}
+	served, err := t.negotiator.Negotiate(gvr)
+	if err != nil {
+		return nil, err
+	}
+	internal := gvr.GroupVersion()
+	if served == internal {
+		return t.delegate.RoundTrip(req)
+	}
+
+	req, err = convertRequest(req, internal, served)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.delegate.RoundTrip(req)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 || !isJSON(resp.Header) {
+		return resp, err
+	}
+	if isWatch(req) {
+		resp.Body = &watchBody{
+			source:  resp.Body,
+			decoder: json.NewDecoder(resp.Body),
+			convert: func(obj map[string]interface{}) { convertObject(obj, served, internal) },
+		}
+		return resp, nil
+	}
+	return convertResponse(resp, served, internal)
+}
+
+// parseResourcePath extracts the group, version and resource from API paths
+// like /apis/GROUP/VERSION/[namespaces/NAMESPACE/]RESOURCE[/...] or
+// /apis/GROUP/VERSION/watch/[namespaces/NAMESPACE/]RESOURCE[/...]
+func parseResourcePath(path string) (schema.GroupVersionResource, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 4 || parts[0] != "apis" {
+		return schema.GroupVersionResource{}, false
+	}
+	gvr := schema.GroupVersionResource{Group: parts[1], Version: parts[2]}
+	rest := parts[3:]
+	if rest[0] == "watch" {
+		rest = rest[1:]
+	}
+	if len(rest) >= 3 && rest[0] == "namespaces" {
+		rest = rest[2:]
+	}
+	if len(rest) == 0 || rest[0] == "" {
+		return schema.GroupVersionResource{}, false
+	}
+	gvr.Resource = rest[0]
+	return gvr, true
+}
+
+func convertRequest(req *http.Request, from schema.GroupVersion, to schema.GroupVersion) (*http.Request, error) {
+	prefix := "/apis/" + from.String() + "/"
+	converted := req.Clone(req.Context())
+	converted.URL.Path = "/apis/" + to.String() + "/" + strings.TrimPrefix(req.URL.Path, prefix)
+	converted.URL.RawPath = ""
+	if req.Body == nil || req.Body == http.NoBody || !isJSON(req.Header) {
+		return converted, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	body, err = convertJSON(body, from, to)
+	if err != nil {
+		return nil, err
+	}
+	converted.Body = ioutil.NopCloser(bytes.NewReader(body))
+	converted.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	converted.ContentLength = int64(len(body))
+	return converted, nil
+}
+
+func convertResponse(resp *http.Response, from schema.GroupVersion, to schema.GroupVersion) (*http.Response, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	body, err = convertJSON(body, from, to)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+	return resp, nil
+}
+
+// convertJSON converts the object or list given as JSON, leaving everything
+// which isn't an object of the given version (like patches) untouched
+func convertJSON(body []byte, from schema.GroupVersion, to schema.GroupVersion) ([]byte, error) {
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return body, nil
+	}
+	if !convertObject(obj, from, to) {
+		return body, nil
+	}
+	return json.Marshal(obj)
+}
+
+// watchBody converts the objects of watch events streamed as JSON
+type watchBody struct {
+	source  io.ReadCloser
+	decoder *json.Decoder
+	convert func(obj map[string]interface{})
+	buffer  bytes.Buffer
+}
+
+func (w *watchBody) Read(p []byte) (int, error) {
+	if w.buffer.Len() == 0 {
+		event := map[string]interface{}{}
+		if err := w.decoder.Decode(&event); err != nil {
+			return 0, err
+		}
+		if obj, ok := event["object"].(map[string]interface{}); ok {
+			w.convert(obj)
+		}
+		if err := json.NewEncoder(&w.buffer).Encode(event); err != nil {
+			return 0, err
+		}
+	}
+	return w.buffer.Read(p)
+}
+
+func (w *watchBody) Close() error {
+	return w.source.Close()
+}
+
+func isJSON(header http.Header) bool {
+	return strings.HasPrefix(header.Get("Content-Type"), "application/json")
+}
+
+func isWatch(req *http.Request) bool {
+	return req.URL.Query().Get("watch") == "true" || req.URL.Query().Get("watch") == "1" ||
+		strings.Contains(req.URL.Path, "/watch/")
+}
@@ -0,0 +1,126 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package negotiation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"knative.dev/eventing/pkg/apis/sources/v1alpha2"
+	sourcesv1alpha2client "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2"
+)
+
+const pingSourceV1 = `{
+  "apiVersion": "sources.knative.dev/v1",
+  "kind": "PingSource",
+  "metadata": {"name": "foo", "namespace": "default"},
+  "spec": {"schedule": "* * * * *", "contentType": "application/json", "data": "{\"a\": 1}"}
+}`
+
+func TestTransportConvertsObjects(t *testing.T) {
+	var received map[string]interface{}
+	server := newDiscoveryServer(map[string][]string{
+		"sources.knative.dev/v1": {"pingsources"},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/apis/sources.knative.dev/v1/namespaces/default/pingsources/foo":
+			w.Write([]byte(pingSourceV1))
+		case r.Method == "GET" && r.URL.Path == "/apis/sources.knative.dev/v1/namespaces/default/pingsources" && r.URL.Query().Get("watch") == "true":
+			w.Write([]byte(`{"type": "ADDED", "object": ` + pingSourceV1 + "}\n"))
+		case r.Method == "GET" && r.URL.Path == "/apis/sources.knative.dev/v1/namespaces/default/pingsources":
+			w.Write([]byte(`{"apiVersion": "sources.knative.dev/v1", "kind": "PingSourceList", "items": [` + pingSourceV1 + `]}`))
+		case r.Method == "POST" && r.URL.Path == "/apis/sources.knative.dev/v1/namespaces/default/pingsources":
+			body, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(body, &received)
+			w.Write(body)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	defer server.Close()
+
+	config := &rest.Config{Host: server.URL, WrapTransport: NewTransportWrapper(newTestNegotiator(t, server))}
+	client, err := sourcesv1alpha2client.NewForConfig(config)
+	assert.NilError(t, err)
+	pingSources := client.PingSources("default")
+
+	source, err := pingSources.Get("foo", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, source.Spec.Schedule, "* * * * *")
+	assert.Equal(t, source.Spec.JsonData, `{"a": 1}`)
+
+	list, err := pingSources.List(metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 1)
+	assert.Equal(t, list.Items[0].Spec.JsonData, `{"a": 1}`)
+
+	watcher, err := pingSources.Watch(metav1.ListOptions{})
+	assert.NilError(t, err)
+	event := <-watcher.ResultChan()
+	watcher.Stop()
+	assert.Equal(t, event.Type, watch.Added)
+	assert.Equal(t, event.Object.(*v1alpha2.PingSource).Spec.JsonData, `{"a": 1}`)
+
+	created, err := pingSources.Create(&v1alpha2.PingSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "default"},
+		Spec:       v1alpha2.PingSourceSpec{Schedule: "@hourly", JsonData: "{}"},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, received["apiVersion"], "sources.knative.dev/v1")
+	spec := received["spec"].(map[string]interface{})
+	assert.Equal(t, spec["data"], "{}")
+	assert.Equal(t, spec["contentType"], "application/json")
+	assert.Assert(t, spec["jsonData"] == nil)
+	assert.Equal(t, created.Spec.JsonData, "{}")
+}
+
+func TestTransportPassesThroughServedVersion(t *testing.T) {
+	server := newDiscoveryServer(map[string][]string{
+		"sources.knative.dev/v1alpha2": {"pingsources"},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/apis/sources.knative.dev/v1alpha2/namespaces/default/pingsources/foo")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"apiVersion": "sources.knative.dev/v1alpha2", "kind": "PingSource", "metadata": {"name": "foo"}, "spec": {"jsonData": "x"}}`))
+	})
+	defer server.Close()
+
+	config := &rest.Config{Host: server.URL, WrapTransport: NewTransportWrapper(newTestNegotiator(t, server))}
+	client, err := sourcesv1alpha2client.NewForConfig(config)
+	assert.NilError(t, err)
+	source, err := client.PingSources("default").Get("foo", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, source.Spec.JsonData, "x")
+}
+
+func TestParseResourcePath(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/apis/sources.knative.dev/v1alpha2/namespaces/default/pingsources/foo":   "pingsources",
+		"/apis/sources.knative.dev/v1alpha2/watch/namespaces/default/pingsources": "pingsources",
+		"/apis/sources.knative.dev/v1alpha2/pingsources":                          "pingsources",
+		"/apis/sources.knative.dev/v1alpha2":                                      "",
+		"/api/v1/namespaces/default/pods":                                         "",
+	} {
+		gvr, ok := parseResourcePath(path)
+		assert.Equal(t, ok, expected != "", path)
+		assert.Equal(t, gvr.Resource, expected, path)
+	}
+}
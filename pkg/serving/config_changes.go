@@ -25,6 +25,7 @@ import (
 	"unicode"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/ptr"
 	"knative.dev/serving/pkg/apis/autoscaling"
@@ -71,6 +72,43 @@ func UpdateEnvVars(template *servingv1.RevisionTemplateSpec, toUpdate map[string
 	return nil
 }
 
+// UpdateEnvValueFrom adds or updates environment variables which take their value
+// from a key of a Secret (secret:NAME:KEY) or ConfigMap (cm:NAME:KEY or config-map:NAME:KEY),
+// a field of the pod (fieldRef:PATH) or a resource of the container (resourceFieldRef:RESOURCE).
+// Variables in toRemove are removed.
+func UpdateEnvValueFrom(template *servingv1.RevisionTemplateSpec, toUpdate map[string]string, toRemove []string) error {
+	container, err := ContainerOfRevisionTemplate(template)
+	if err != nil {
+		return err
+	}
+	updated := container.Env
+	for name, spec := range toUpdate {
+		source, err := parseEnvVarSource(spec)
+		if err != nil {
+			return err
+		}
+		found := false
+		for i := range updated {
+			if updated[i].Name == name {
+				updated[i].Value = ""
+				updated[i].ValueFrom = source
+				found = true
+				break
+			}
+		}
+		if !found {
+			updated = append(updated, corev1.EnvVar{Name: name, ValueFrom: source})
+		}
+	}
+	updated = removeEnvVars(updated, toRemove)
+	// Sort by env key name
+	sort.SliceStable(updated, func(i, j int) bool {
+		return updated[i].Name < updated[j].Name
+	})
+	container.Env = updated
+	return nil
+}
+
 // UpdateEnvFrom updates envFrom
 func UpdateEnvFrom(template *servingv1.RevisionTemplateSpec, toUpdate []string, toRemove []string) error {
 	container, err := ContainerOfRevisionTemplate(template)
@@ -263,6 +301,55 @@ func UpdateImage(template *servingv1.RevisionTemplateSpec, image string) error {
 	return nil
 }
 
+// UpdateSidecars adds the sidecar containers given as name to image mapping,
+// or updates their image if they already exist, and removes the sidecars with
+// the given names. The first container is never considered to be a sidecar.
+func UpdateSidecars(template *servingv1.RevisionTemplateSpec, toUpdate *util.OrderedMap, toRemove []string) error {
+	if len(template.Spec.Containers) == 0 {
+		return fmt.Errorf("internal: no container set in spec.template.spec.containers")
+	}
+	sidecars := append([]corev1.Container{}, template.Spec.Containers[1:]...)
+	for _, name := range toRemove {
+		if name == template.Spec.Containers[0].Name {
+			return fmt.Errorf("container '%s' is not a sidecar and can't be removed", name)
+		}
+		found := false
+		for i, container := range sidecars {
+			if container.Name == name {
+				sidecars = append(sidecars[:i], sidecars[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("no sidecar '%s' found to remove", name)
+		}
+	}
+
+	it := toUpdate.Iterator()
+	for name, image, ok := it.NextString(); ok; name, image, ok = it.NextString() {
+		if name == "" || image == "" {
+			return fmt.Errorf("sidecar requires a name and an image in the form NAME=IMAGE")
+		}
+		if name == template.Spec.Containers[0].Name {
+			return fmt.Errorf("container '%s' is not a sidecar, use --image to change its image", name)
+		}
+		found := false
+		for i := range sidecars {
+			if sidecars[i].Name == name {
+				sidecars[i].Image = image
+				found = true
+				break
+			}
+		}
+		if !found {
+			sidecars = append(sidecars, corev1.Container{Name: name, Image: image})
+		}
+	}
+	template.Spec.Containers = append(template.Spec.Containers[:1], sidecars...)
+	return nil
+}
+
 // UnsetUserImageAnnot removes the user image annotation
 func UnsetUserImageAnnot(template *servingv1.RevisionTemplateSpec) {
 	delete(template.Annotations, UserImageAnnotationKey)
@@ -414,6 +501,30 @@ func UpdateResourcesDeprecated(template *servingv1.RevisionTemplateSpec, request
 	return nil
 }
 
+// UpdateReadinessProbe sets the readiness probe of the container. The probe is
+// given as http:PATH[:PORT], tcp:PORT or exec:CMD[,ARG...] and replaces the
+// action of an existing probe, keeping its settings. A probe of "-" removes the
+// readiness probe. The options set the probe's timing and threshold fields.
+func UpdateReadinessProbe(template *servingv1.RevisionTemplateSpec, probe string, options map[string]string) error {
+	container, err := ContainerOfRevisionTemplate(template)
+	if err != nil {
+		return err
+	}
+	container.ReadinessProbe, err = updateProbe(container.ReadinessProbe, probe, options)
+	return err
+}
+
+// UpdateLivenessProbe sets the liveness probe of the container in the same way
+// as UpdateReadinessProbe does for the readiness probe.
+func UpdateLivenessProbe(template *servingv1.RevisionTemplateSpec, probe string, options map[string]string) error {
+	container, err := ContainerOfRevisionTemplate(template)
+	if err != nil {
+		return err
+	}
+	container.LivenessProbe, err = updateProbe(container.LivenessProbe, probe, options)
+	return err
+}
+
 // UpdateLabels updates the labels by adding items from `add` then removing any items from `remove`
 func UpdateLabels(labelsMap map[string]string, add map[string]string, remove []string) map[string]string {
 	if labelsMap == nil {
@@ -509,6 +620,7 @@ func updateEnvVarsFromMap(env []corev1.EnvVar, toUpdate map[string]string) []cor
 		envVar := &env[i]
 		if val, ok := toUpdate[envVar.Name]; ok {
 			envVar.Value = val
+			envVar.ValueFrom = nil
 			set.Insert(envVar.Name)
 		}
 	}
@@ -780,6 +892,133 @@ func existsVolumeNameInVolumeMounts(volumeName string, volumeMounts []corev1.Vol
 	return false
 }
 
+func updateProbe(probe *corev1.Probe, spec string, options map[string]string) (*corev1.Probe, error) {
+	if spec == "-" {
+		if len(options) > 0 {
+			return nil, fmt.Errorf("probe options can't be set when removing the probe")
+		}
+		return nil, nil
+	}
+	if spec != "" {
+		handler, err := parseProbeHandler(spec)
+		if err != nil {
+			return nil, err
+		}
+		if probe == nil {
+			probe = &corev1.Probe{}
+		}
+		probe.Handler = *handler
+	}
+	if len(options) == 0 {
+		return probe, nil
+	}
+	if probe == nil {
+		return nil, fmt.Errorf("no probe configured to apply the options to, please specify the probe too")
+	}
+	for key, value := range options {
+		number, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("probe option %s requires a non-negative integer, got '%s'", key, value)
+		}
+		switch key {
+		case "InitialDelaySeconds":
+			probe.InitialDelaySeconds = int32(number)
+		case "PeriodSeconds":
+			probe.PeriodSeconds = int32(number)
+		case "TimeoutSeconds":
+			probe.TimeoutSeconds = int32(number)
+		case "FailureThreshold":
+			probe.FailureThreshold = int32(number)
+		default:
+			return nil, fmt.Errorf("unknown probe option '%s', supported options: %s", key, strings.Join(probeOptions, ", "))
+		}
+	}
+	return probe, nil
+}
+
+// probeOptions are the options which can be set on a probe, in display order
+var probeOptions = []string{"InitialDelaySeconds", "PeriodSeconds", "TimeoutSeconds", "FailureThreshold"}
+
+func parseProbeHandler(spec string) (*corev1.Handler, error) {
+	parts := strings.SplitN(spec, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("probe '%s' must be one of http:PATH[:PORT], tcp:PORT or exec:CMD[,ARG...]", spec)
+	}
+	switch parts[0] {
+	case "http":
+		action := &corev1.HTTPGetAction{Path: parts[1]}
+		// A trailing number is the port, anything else belongs to the path
+		if i := strings.LastIndex(parts[1], ":"); i >= 0 && isDigits(parts[1][i+1:]) {
+			port, err := parseProbePort(parts[1][i+1:])
+			if err != nil {
+				return nil, err
+			}
+			action.Path, action.Port = parts[1][:i], port
+		}
+		return &corev1.Handler{HTTPGet: action}, nil
+	case "tcp":
+		port, err := parseProbePort(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		return &corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: port}}, nil
+	case "exec":
+		// The command and its arguments are separated by commas, so that
+		// arguments can contain spaces
+		command := strings.Split(parts[1], ",")
+		if command[0] == "" {
+			return nil, fmt.Errorf("probe '%s' requires a command in the form exec:CMD[,ARG...]", spec)
+		}
+		return &corev1.Handler{Exec: &corev1.ExecAction{Command: command}}, nil
+	}
+	return nil, fmt.Errorf("unknown probe type '%s' in '%s', must be one of http, tcp or exec", parts[0], spec)
+}
+
+func parseProbePort(port string) (intstr.IntOrString, error) {
+	number, err := strconv.ParseInt(port, 10, 32)
+	if err != nil || number < 1 || number > 65535 {
+		return intstr.IntOrString{}, fmt.Errorf("probe port '%s' must be a number between 1 and 65535", port)
+	}
+	return intstr.FromInt(int(number)), nil
+}
+
+func parseEnvVarSource(spec string) (*corev1.EnvVarSource, error) {
+	parts := strings.SplitN(spec, ":", 2)
+	if len(parts) == 2 && parts[1] != "" {
+		switch parts[0] {
+		case "secret", "cm", "config-map":
+			ref := strings.SplitN(parts[1], ":", 2)
+			if len(ref) != 2 || ref[0] == "" || ref[1] == "" {
+				return nil, fmt.Errorf("reference '%s' requires a name and a key in the form %s:NAME:KEY", spec, parts[0])
+			}
+			if parts[0] == "secret" {
+				return &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ref[0]},
+					Key:                  ref[1],
+				}}, nil
+			}
+			return &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref[0]},
+				Key:                  ref[1],
+			}}, nil
+		case "fieldRef":
+			return &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: parts[1]}}, nil
+		case "resourceFieldRef":
+			return &corev1.EnvVarSource{ResourceFieldRef: &corev1.ResourceFieldSelector{Resource: parts[1]}}, nil
+		}
+	}
+	return nil, fmt.Errorf("reference '%s' must be one of secret:NAME:KEY, cm:NAME:KEY, fieldRef:PATH or resourceFieldRef:RESOURCE", spec)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
 func appendCheckSum(sanitiedString string, path string) string {
 	checkSum := sha1.Sum([]byte(path))
 	shortCheckSum := checkSum[0:4]
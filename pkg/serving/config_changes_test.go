@@ -28,6 +28,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
@@ -668,6 +669,148 @@ func TestUpdateUser(t *testing.T) {
 	checkUserUpdate(t, template, ptr.Int64(int64(1002)))
 }
 
+func TestUpdateEnvValueFrom(t *testing.T) {
+	template, container := getRevisionTemplate()
+	container.Env = []corev1.EnvVar{
+		{Name: "a", Value: "foo"},
+		{Name: "b", Value: "bar"},
+	}
+
+	err := UpdateEnvValueFrom(template, map[string]string{
+		"a":  "secret:db:password",
+		"c":  "cm:cfg:key",
+		"d":  "config-map:cfg:other",
+		"ns": "fieldRef:metadata.namespace",
+		"m":  "resourceFieldRef:limits.memory",
+	}, []string{"b"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, container.Env, []corev1.EnvVar{
+		{Name: "a", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "db"}, Key: "password"}}},
+		{Name: "c", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "cfg"}, Key: "key"}}},
+		{Name: "d", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "cfg"}, Key: "other"}}},
+		{Name: "m", ValueFrom: &corev1.EnvVarSource{ResourceFieldRef: &corev1.ResourceFieldSelector{Resource: "limits.memory"}}},
+		{Name: "ns", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
+	})
+
+	// Setting a literal value replaces the reference
+	err = UpdateEnvVars(template, map[string]string{"a": "baz"}, []string{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, container.Env[0], corev1.EnvVar{Name: "a", Value: "baz"})
+
+	for _, data := range []struct {
+		spec string
+		err  string
+	}{
+		{"secret:db", "requires a name and a key in the form secret:NAME:KEY"},
+		{"cm::key", "requires a name and a key"},
+		{"fieldRef:", "must be one of"},
+		{"foo:bar", "must be one of"},
+		{"", "must be one of"},
+	} {
+		err = UpdateEnvValueFrom(template, map[string]string{"x": data.spec}, []string{})
+		assert.ErrorContains(t, err, data.err)
+	}
+}
+
+func TestUpdateProbes(t *testing.T) {
+	template, container := getRevisionTemplate()
+
+	err := UpdateReadinessProbe(template, "http:/healthz:8080", map[string]string{"InitialDelaySeconds": "5"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, container.ReadinessProbe, &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)},
+		},
+		InitialDelaySeconds: 5,
+	})
+
+	// Changing the action keeps the options
+	err = UpdateReadinessProbe(template, "tcp:8080", nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, container.ReadinessProbe, &corev1.Probe{
+		Handler:             corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(8080)}},
+		InitialDelaySeconds: 5,
+	})
+
+	err = UpdateLivenessProbe(template, "exec:cat,/tmp/healthy", nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, container.LivenessProbe.Exec.Command, []string{"cat", "/tmp/healthy"})
+
+	// Arguments can contain spaces
+	err = UpdateLivenessProbe(template, "exec:sh,-c,test -f /tmp/ready", nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, container.LivenessProbe.Exec.Command, []string{"sh", "-c", "test -f /tmp/ready"})
+
+	err = UpdateLivenessProbe(template, "", map[string]string{"PeriodSeconds": "10", "TimeoutSeconds": "2", "FailureThreshold": "3"})
+	assert.NilError(t, err)
+	assert.Equal(t, container.LivenessProbe.PeriodSeconds, int32(10))
+	assert.Equal(t, container.LivenessProbe.TimeoutSeconds, int32(2))
+	assert.Equal(t, container.LivenessProbe.FailureThreshold, int32(3))
+	assert.Assert(t, container.LivenessProbe.Exec != nil)
+
+	err = UpdateReadinessProbe(template, "-", nil)
+	assert.NilError(t, err)
+	assert.Assert(t, container.ReadinessProbe == nil)
+
+	// A path can contain colons without a port
+	err = UpdateReadinessProbe(template, "http:/status:ready", nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, container.ReadinessProbe.HTTPGet, &corev1.HTTPGetAction{Path: "/status:ready"})
+
+	for _, data := range []struct {
+		probe   string
+		options map[string]string
+		err     string
+	}{
+		{"grpc:8080", nil, "unknown probe type 'grpc'"},
+		{"tcp", nil, "must be one of http:PATH[:PORT], tcp:PORT or exec:CMD[,ARG...]"},
+		{"tcp:http", nil, "probe port 'http' must be a number"},
+		{"exec:,/tmp/ready", nil, "requires a command"},
+		{"http:/healthz:70000", nil, "must be a number between 1 and 65535"},
+		{"tcp:8080", map[string]string{"Foo": "1"}, "unknown probe option 'Foo'"},
+		{"tcp:8080", map[string]string{"PeriodSeconds": "-1"}, "requires a non-negative integer"},
+		{"-", map[string]string{"PeriodSeconds": "1"}, "can't be set when removing"},
+	} {
+		err = UpdateLivenessProbe(template, data.probe, data.options)
+		assert.ErrorContains(t, err, data.err)
+	}
+
+	template, _ = getRevisionTemplate()
+	err = UpdateReadinessProbe(template, "", map[string]string{"PeriodSeconds": "1"})
+	assert.ErrorContains(t, err, "no probe configured")
+}
+
+func TestUpdateSidecars(t *testing.T) {
+	template, container := getRevisionTemplate()
+	container.Image = "gcr.io/foo/bar:baz"
+
+	toUpdate := util.NewOrderedMapWithKVStrings([][]string{{"logger", "fluent/bit"}, {"proxy", "envoy:v1"}})
+	err := UpdateSidecars(template, toUpdate, []string{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(template.Spec.Containers), 3)
+	assert.Equal(t, template.Spec.Containers[0].Image, "gcr.io/foo/bar:baz")
+	assert.DeepEqual(t, template.Spec.Containers[1], corev1.Container{Name: "logger", Image: "fluent/bit"})
+	assert.DeepEqual(t, template.Spec.Containers[2], corev1.Container{Name: "proxy", Image: "envoy:v1"})
+
+	toUpdate = util.NewOrderedMapWithKVStrings([][]string{{"proxy", "envoy:v2"}})
+	err = UpdateSidecars(template, toUpdate, []string{"logger"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(template.Spec.Containers), 2)
+	assert.DeepEqual(t, template.Spec.Containers[1], corev1.Container{Name: "proxy", Image: "envoy:v2"})
+
+	err = UpdateSidecars(template, util.NewOrderedMap(), []string{"logger"})
+	assert.ErrorContains(t, err, "no sidecar 'logger'")
+
+	template.Spec.Containers[0].Name = "user-container"
+	err = UpdateSidecars(template, util.NewOrderedMap(), []string{"user-container"})
+	assert.ErrorContains(t, err, "not a sidecar")
+	err = UpdateSidecars(template, util.NewOrderedMapWithKVStrings([][]string{{"user-container", "foo"}}), []string{})
+	assert.ErrorContains(t, err, "not a sidecar")
+}
+
 //
 // =========================================================================================================
 
@@ -17,6 +17,7 @@ package serving
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,6 +41,27 @@ func ContainerOfRevisionSpec(revisionSpec *servingv1.RevisionSpec) (*corev1.Cont
 	return &revisionSpec.Containers[0], nil
 }
 
+// ContainerIndex returns the index of the container with the given name. An
+// empty name selects the first container, which is the one serving requests.
+func ContainerIndex(revisionSpec *servingv1.RevisionSpec, name string) (int, error) {
+	if name == "" {
+		return 0, nil
+	}
+	names := make([]string, 0, len(revisionSpec.Containers))
+	for i, container := range revisionSpec.Containers {
+		if container.Name == name {
+			return i, nil
+		}
+		if container.Name != "" {
+			names = append(names, container.Name)
+		}
+	}
+	if len(names) == 0 {
+		return 0, fmt.Errorf("no container '%s' found, no named containers available", name)
+	}
+	return 0, fmt.Errorf("no container '%s' found, available containers: %s", name, strings.Join(names, ", "))
+}
+
 func ScalingInfo(m *metav1.ObjectMeta) (*Scaling, error) {
 	ret := &Scaling{}
 	var err error
@@ -84,8 +106,64 @@ func Port(revisionSpec *servingv1.RevisionSpec) *int32 {
 	return nil
 }
 
+// EnvVarValue returns the value of an environment variable or a description
+// of the reference it is taken from
+func EnvVarValue(env corev1.EnvVar) string {
+	if env.ValueFrom == nil {
+		return env.Value
+	}
+	switch {
+	case env.ValueFrom.SecretKeyRef != nil:
+		return fmt.Sprintf("secret:%s:%s", env.ValueFrom.SecretKeyRef.Name, env.ValueFrom.SecretKeyRef.Key)
+	case env.ValueFrom.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("cm:%s:%s", env.ValueFrom.ConfigMapKeyRef.Name, env.ValueFrom.ConfigMapKeyRef.Key)
+	case env.ValueFrom.FieldRef != nil:
+		return "fieldRef:" + env.ValueFrom.FieldRef.FieldPath
+	case env.ValueFrom.ResourceFieldRef != nil:
+		return "resourceFieldRef:" + env.ValueFrom.ResourceFieldRef.Resource
+	}
+	return "[ref]"
+}
+
 // =======================================================================================
 
+// ProbeDescription describes the probe in the format of the probe flags,
+// followed by the options which are set, e.g. "tcp:8080 (PeriodSeconds=5)"
+func ProbeDescription(probe *corev1.Probe) string {
+	var desc string
+	switch {
+	case probe.HTTPGet != nil:
+		desc = "http:" + probe.HTTPGet.Path
+		if port := probe.HTTPGet.Port.String(); port != "0" && port != "" {
+			desc += ":" + port
+		}
+	case probe.TCPSocket != nil:
+		desc = "tcp:" + probe.TCPSocket.Port.String()
+	case probe.Exec != nil:
+		desc = "exec:" + strings.Join(probe.Exec.Command, ",")
+	default:
+		desc = "unknown"
+	}
+	var options []string
+	for _, option := range []struct {
+		name  string
+		value int32
+	}{
+		{"InitialDelaySeconds", probe.InitialDelaySeconds},
+		{"PeriodSeconds", probe.PeriodSeconds},
+		{"TimeoutSeconds", probe.TimeoutSeconds},
+		{"FailureThreshold", probe.FailureThreshold},
+	} {
+		if option.value != 0 {
+			options = append(options, fmt.Sprintf("%s=%d", option.name, option.value))
+		}
+	}
+	if len(options) > 0 {
+		desc += " (" + strings.Join(options, ", ") + ")"
+	}
+	return desc
+}
+
 func annotationAsInt(m *metav1.ObjectMeta, annotationKey string) (*int, error) {
 	annos := m.Annotations
 	if val, ok := annos[annotationKey]; ok {
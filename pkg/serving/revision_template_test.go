@@ -18,9 +18,12 @@ import (
 	"testing"
 
 	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
 type scalingInfoTest struct {
@@ -71,3 +74,57 @@ func TestScalingInfo(t *testing.T) {
 
 	}
 }
+
+func TestEnvVarValue(t *testing.T) {
+	ref := corev1.LocalObjectReference{Name: "ref"}
+	for _, d := range []struct {
+		source   *corev1.EnvVarSource
+		expected string
+	}{
+		{nil, "value"},
+		{&corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: ref, Key: "key"}}, "secret:ref:key"},
+		{&corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: ref, Key: "key"}}, "cm:ref:key"},
+		{&corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"}}, "fieldRef:status.podIP"},
+		{&corev1.EnvVarSource{ResourceFieldRef: &corev1.ResourceFieldSelector{Resource: "limits.cpu"}}, "resourceFieldRef:limits.cpu"},
+	} {
+		assert.Equal(t, EnvVarValue(corev1.EnvVar{Name: "A", Value: "value", ValueFrom: d.source}), d.expected)
+	}
+}
+
+func TestContainerIndex(t *testing.T) {
+	spec := &servingv1.RevisionSpec{PodSpec: corev1.PodSpec{
+		Containers: []corev1.Container{{}, {Name: "logger"}, {Name: "proxy"}},
+	}}
+	index, err := ContainerIndex(spec, "")
+	assert.NilError(t, err)
+	assert.Equal(t, index, 0)
+
+	index, err = ContainerIndex(spec, "proxy")
+	assert.NilError(t, err)
+	assert.Equal(t, index, 2)
+
+	_, err = ContainerIndex(spec, "foo")
+	assert.ErrorContains(t, err, "no container 'foo' found, available containers: logger, proxy")
+
+	_, err = ContainerIndex(&servingv1.RevisionSpec{PodSpec: corev1.PodSpec{Containers: []corev1.Container{{}}}}, "foo")
+	assert.ErrorContains(t, err, "no named containers")
+}
+
+func TestProbeDescription(t *testing.T) {
+	for _, d := range []struct {
+		probe    corev1.Probe
+		expected string
+	}{
+		{corev1.Probe{Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz"}}}, "http:/healthz"},
+		{corev1.Probe{
+			Handler:             corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)}},
+			InitialDelaySeconds: 5,
+			FailureThreshold:    3,
+		}, "http:/healthz:8080 (InitialDelaySeconds=5, FailureThreshold=3)"},
+		{corev1.Probe{Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(8080)}}}, "tcp:8080"},
+		{corev1.Probe{Handler: corev1.Handler{Exec: &corev1.ExecAction{Command: []string{"cat", "/tmp/ready"}}}, PeriodSeconds: 10}, "exec:cat,/tmp/ready (PeriodSeconds=10)"},
+		{corev1.Probe{}, "unknown"},
+	} {
+		assert.Equal(t, ProbeDescription(&d.probe), d.expected)
+	}
+}
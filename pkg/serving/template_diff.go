@@ -0,0 +1,229 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// FieldDiff is the difference of a single field. An empty From means that the
+// field has been added, an empty To that it has been removed.
+type FieldDiff struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to,omitempty"`
+}
+
+// Order of the fields in a diff, fields with a key like env[NAME] are
+// sorted by their key within their group. Fields of sidecars are prefixed with
+// sidecar[NAME]. and sorted by the sidecar name first.
+var fieldOrder = []string{
+	"image", "port", "env", "env-from", "mount", "requests", "limits",
+	"sidecar", "min-scale", "max-scale", "concurrency-limit", "concurrency-target",
+	"concurrency-utilization", "autoscale-window", "traffic",
+}
+
+// DiffRevisionTemplates compares the image, port, environment, mounts, resources,
+// sidecars and autoscaling settings of two revision templates
+func DiffRevisionTemplates(from, to *servingv1.RevisionTemplateSpec) []FieldDiff {
+	return diffFields(templateFields(from), templateFields(to))
+}
+
+// DiffTraffic compares two traffic blocks. Targets are identified by their
+// tag and revision, with @latest for the latest ready revision.
+func DiffTraffic(from, to []servingv1.TrafficTarget) []FieldDiff {
+	return diffFields(trafficFields(from), trafficFields(to))
+}
+
+func templateFields(template *servingv1.RevisionTemplateSpec) map[string]string {
+	fields := map[string]string{}
+	container, err := ContainerOfRevisionTemplate(template)
+	if err != nil {
+		return fields
+	}
+	addContainerFields(fields, "", container, template.Spec.Volumes)
+	if port := Port(&template.Spec); port != nil {
+		fields["port"] = strconv.Itoa(int(*port))
+	}
+	for i, sidecar := range template.Spec.Containers[1:] {
+		name := sidecar.Name
+		if name == "" {
+			name = strconv.Itoa(i + 1)
+		}
+		addContainerFields(fields, "sidecar["+name+"].", &sidecar, template.Spec.Volumes)
+	}
+
+	meta := &template.ObjectMeta
+	if scaling, err := ScalingInfo(meta); err == nil {
+		addIntField(fields, "min-scale", scaling.Min)
+		addIntField(fields, "max-scale", scaling.Max)
+	}
+	if limit := template.Spec.ContainerConcurrency; limit != nil && *limit != 0 {
+		fields["concurrency-limit"] = strconv.FormatInt(*limit, 10)
+	}
+	addIntField(fields, "concurrency-target", ConcurrencyTarget(meta))
+	addIntField(fields, "concurrency-utilization", ConcurrencyTargetUtilization(meta))
+	if window := AutoscaleWindow(meta); window != "" {
+		fields["autoscale-window"] = window
+	}
+	return fields
+}
+
+// addContainerFields adds the image, environment, mounts and resources of a
+// container with the given prefix to the fields
+func addContainerFields(fields map[string]string, prefix string, container *corev1.Container, volumes []corev1.Volume) {
+	fields[prefix+"image"] = container.Image
+	for _, env := range container.Env {
+		value := EnvVarValue(env)
+		if value == "" {
+			value = `""`
+		}
+		fields[prefix+"env["+env.Name+"]"] = value
+	}
+	var envFrom []string
+	for _, source := range container.EnvFrom {
+		if source.ConfigMapRef != nil {
+			envFrom = append(envFrom, "cm:"+source.ConfigMapRef.Name)
+		}
+		if source.SecretRef != nil {
+			envFrom = append(envFrom, "secret:"+source.SecretRef.Name)
+		}
+	}
+	if len(envFrom) > 0 {
+		fields[prefix+"env-from"] = strings.Join(envFrom, ", ")
+	}
+	for _, mount := range container.VolumeMounts {
+		fields[prefix+"mount["+mount.MountPath+"]"] = volumeDescription(volumes, mount)
+	}
+	for name, quantity := range container.Resources.Requests {
+		fields[prefix+"requests."+string(name)] = quantity.String()
+	}
+	for name, quantity := range container.Resources.Limits {
+		fields[prefix+"limits."+string(name)] = quantity.String()
+	}
+}
+
+func trafficFields(traffic []servingv1.TrafficTarget) map[string]string {
+	fields := map[string]string{}
+	for _, target := range traffic {
+		key := target.RevisionName
+		if target.LatestRevision != nil && *target.LatestRevision {
+			key = "@latest"
+		}
+		if target.Tag != "" {
+			key = target.Tag + "=" + key
+		}
+		percent := int64(0)
+		if target.Percent != nil {
+			percent = *target.Percent
+		}
+		fields["traffic["+key+"]"] = fmt.Sprintf("%d%%", percent)
+	}
+	return fields
+}
+
+// volumeDescription describes the volume used by the given mount
+func volumeDescription(volumes []corev1.Volume, mount corev1.VolumeMount) string {
+	desc := mount.Name
+	for _, volume := range volumes {
+		if volume.Name != mount.Name {
+			continue
+		}
+		switch {
+		case volume.ConfigMap != nil:
+			desc = "cm:" + volume.ConfigMap.Name
+		case volume.Secret != nil:
+			desc = "secret:" + volume.Secret.SecretName
+		case volume.EmptyDir != nil:
+			desc = "emptyDir"
+		}
+	}
+	if mount.SubPath != "" {
+		desc += " (" + mount.SubPath + ")"
+	}
+	if mount.ReadOnly {
+		desc += " (read-only)"
+	}
+	return desc
+}
+
+func addIntField(fields map[string]string, name string, value *int) {
+	if value != nil {
+		fields[name] = strconv.Itoa(*value)
+	}
+}
+
+func diffFields(from, to map[string]string) []FieldDiff {
+	diffs := []FieldDiff{}
+	for field, fromValue := range from {
+		if toValue, ok := to[field]; !ok || toValue != fromValue {
+			diffs = append(diffs, FieldDiff{Field: field, From: fromValue, To: toValue})
+		}
+	}
+	for field, toValue := range to {
+		if _, ok := from[field]; !ok {
+			diffs = append(diffs, FieldDiff{Field: field, To: toValue})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return fieldLess(diffs[i].Field, diffs[j].Field)
+	})
+	return diffs
+}
+
+func fieldLess(a, b string) bool {
+	ra, rb := fieldRank(a), fieldRank(b)
+	if ra != rb {
+		return ra < rb
+	}
+	if sidecarA, restA, ok := splitSidecarField(a); ok {
+		if sidecarB, restB, ok := splitSidecarField(b); ok {
+			if sidecarA != sidecarB {
+				return sidecarA < sidecarB
+			}
+			return fieldLess(restA, restB)
+		}
+	}
+	return a < b
+}
+
+// splitSidecarField splits a field like sidecar[NAME].image into the name of
+// the sidecar and the field of the container
+func splitSidecarField(field string) (string, string, bool) {
+	if !strings.HasPrefix(field, "sidecar[") {
+		return "", "", false
+	}
+	end := strings.Index(field, "].")
+	if end < 0 {
+		return "", "", false
+	}
+	return field[len("sidecar["):end], field[end+2:], true
+}
+
+func fieldRank(field string) int {
+	group := strings.SplitN(strings.SplitN(field, "[", 2)[0], ".", 2)[0]
+	for i, name := range fieldOrder {
+		if name == group {
+			return i
+		}
+	}
+	return len(fieldOrder)
+}
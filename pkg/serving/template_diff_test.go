@@ -0,0 +1,119 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestDiffRevisionTemplates(t *testing.T) {
+	from := &servingv1.RevisionTemplateSpec{}
+	from.Annotations = map[string]string{autoscaling.MinScaleAnnotationKey: "1", autoscaling.WindowAnnotationKey: "60s"}
+	from.Spec.Containers = []corev1.Container{{
+		Image: "gcr.io/foo/bar:v1",
+		Env: []corev1.EnvVar{
+			{Name: "A", Value: "1"},
+			{Name: "B", Value: "2"},
+			{Name: "EMPTY"},
+		},
+		EnvFrom:      []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "cfg"}}}},
+		VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data", ReadOnly: true}},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+		},
+	}}
+	from.Spec.Volumes = []corev1.Volume{{Name: "data", VolumeSource: corev1.VolumeSource{
+		ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "data-cm"}}}}}
+
+	to := from.DeepCopy()
+	to.Annotations = map[string]string{autoscaling.MinScaleAnnotationKey: "2", autoscaling.TargetAnnotationKey: "10"}
+	to.Spec.ContainerConcurrency = ptr.Int64(5)
+	container := &to.Spec.Containers[0]
+	container.Image = "gcr.io/foo/bar:v2"
+	container.Env = []corev1.EnvVar{
+		{Name: "A", Value: "1"},
+		{Name: "C", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "token"}}},
+	}
+	container.EnvFrom = nil
+	container.VolumeMounts = []corev1.VolumeMount{{Name: "data", MountPath: "/data"}}
+	container.Resources.Requests[corev1.ResourceCPU] = resource.MustParse("200m")
+	container.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+	to.Spec.Volumes[0].VolumeSource = corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "data-secret"}}
+
+	assert.DeepEqual(t, DiffRevisionTemplates(from, to), []FieldDiff{
+		{Field: "image", From: "gcr.io/foo/bar:v1", To: "gcr.io/foo/bar:v2"},
+		{Field: "env[B]", From: "2"},
+		{Field: "env[C]", To: "secret:creds:token"},
+		{Field: "env[EMPTY]", From: `""`},
+		{Field: "env-from", From: "cm:cfg"},
+		{Field: "mount[/data]", From: "cm:data-cm (read-only)", To: "secret:data-secret"},
+		{Field: "requests.cpu", From: "100m", To: "200m"},
+		{Field: "limits.memory", To: "1Gi"},
+		{Field: "min-scale", From: "1", To: "2"},
+		{Field: "concurrency-limit", To: "5"},
+		{Field: "concurrency-target", To: "10"},
+		{Field: "autoscale-window", From: "60s"},
+	})
+	assert.DeepEqual(t, DiffRevisionTemplates(from, from.DeepCopy()), []FieldDiff{})
+}
+
+func TestDiffRevisionTemplatesSidecars(t *testing.T) {
+	from := &servingv1.RevisionTemplateSpec{}
+	from.Spec.Containers = []corev1.Container{
+		{Image: "gcr.io/foo/bar:v1"},
+		{Name: "proxy", Image: "gcr.io/foo/proxy:v1", Env: []corev1.EnvVar{{Name: "MODE", Value: "tls"}}},
+		{Name: "agent", Image: "gcr.io/foo/agent:v1"},
+	}
+
+	to := from.DeepCopy()
+	to.Spec.Containers[1].Image = "gcr.io/foo/proxy:v2"
+	to.Spec.Containers[1].Env = []corev1.EnvVar{{Name: "MODE", Value: "plain"}}
+	to.Spec.Containers[1].Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")}
+	to.Spec.Containers[2] = corev1.Container{Name: "logger", Image: "gcr.io/foo/logger:v1"}
+	to.Annotations = map[string]string{autoscaling.MaxScaleAnnotationKey: "3"}
+
+	assert.DeepEqual(t, DiffRevisionTemplates(from, to), []FieldDiff{
+		{Field: "sidecar[agent].image", From: "gcr.io/foo/agent:v1"},
+		{Field: "sidecar[logger].image", To: "gcr.io/foo/logger:v1"},
+		{Field: "sidecar[proxy].image", From: "gcr.io/foo/proxy:v1", To: "gcr.io/foo/proxy:v2"},
+		{Field: "sidecar[proxy].env[MODE]", From: "tls", To: "plain"},
+		{Field: "sidecar[proxy].limits.memory", To: "64Mi"},
+		{Field: "max-scale", To: "3"},
+	})
+}
+
+func TestDiffTraffic(t *testing.T) {
+	from := []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		{Tag: "stable", RevisionName: "foo-v1", Percent: ptr.Int64(0)},
+	}
+	to := []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(20)},
+		{RevisionName: "foo-v1", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(80)},
+	}
+	assert.DeepEqual(t, DiffTraffic(from, to), []FieldDiff{
+		{Field: "traffic[@latest]", From: "100%", To: "20%"},
+		{Field: "traffic[foo-v1]", To: "80%"},
+		{Field: "traffic[stable=foo-v1]", From: "0%"},
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clienterrors "knative.dev/client/pkg/errors"
+)
+
+// Apply a service's definition. The service is created if it doesn't exist yet, otherwise
+// a three-way merge between the last applied configuration, the given service and the
+// live service is patched onto the live service.
+func (cl *knServingClient) ApplyService(modifiedService *servingv1.Service) (bool, error) {
+	currentService, err := cl.client.Services(cl.namespace).Get(modifiedService.Name, v1.GetOptions{})
+	notFound := apierrors.IsNotFound(err)
+	if err != nil && !notFound {
+		return false, clienterrors.GetError(err)
+	}
+
+	modified, err := updateLastAppliedAnnotation(modifiedService)
+	if err != nil {
+		return false, err
+	}
+
+	// No current service --> create a new one
+	if notFound {
+		return true, cl.CreateService(modifiedService)
+	}
+
+	patch, err := computeApplyPatch(currentService, modified)
+	if err != nil || patch == nil {
+		return false, err
+	}
+	_, err = cl.client.Services(cl.namespace).Patch(modifiedService.Name, types.MergePatchType, patch)
+	if err != nil {
+		return false, clienterrors.GetError(err)
+	}
+	return true, nil
+}
+
+// computeApplyPatch calculates the JSON merge patch from the last applied configuration stored on the
+// current service, the modified configuration and the current service itself.
+// nil is returned if the patch would not change the service after server-side defaulting has been
+// applied, so that re-applying the same configuration doesn't touch the service.
+func computeApplyPatch(currentService *servingv1.Service, modified []byte) ([]byte, error) {
+	current, err := json.Marshal(currentService)
+	if err != nil {
+		return nil, err
+	}
+	original := []byte(currentService.Annotations[corev1.LastAppliedConfigAnnotation])
+
+	patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, current)
+	if err != nil {
+		return nil, fmt.Errorf("cannot compute changes for service '%s': %v", currentService.Name, err)
+	}
+	if string(patch) == "{}" {
+		return nil, nil
+	}
+
+	changed, err := patchChangesService(currentService, current, patch)
+	if err != nil || !changed {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// patchChangesService checks whether the patch applied to the current service changes
+// its labels, annotations or spec when comparing both with defaults set
+func patchChangesService(currentService *servingv1.Service, current []byte, patch []byte) (bool, error) {
+	patched, err := jsonpatch.MergePatch(current, patch)
+	if err != nil {
+		return false, err
+	}
+	patchedService := &servingv1.Service{}
+	err = json.Unmarshal(patched, patchedService)
+	if err != nil {
+		return false, err
+	}
+	currentService = currentService.DeepCopy()
+
+	ctx := context.Background()
+	patchedService.SetDefaults(ctx)
+	currentService.SetDefaults(ctx)
+
+	return !equality.Semantic.DeepEqual(currentService.Labels, patchedService.Labels) ||
+		!equality.Semantic.DeepEqual(currentService.Annotations, patchedService.Annotations) ||
+		!equality.Semantic.DeepEqual(currentService.Spec, patchedService.Spec), nil
+}
+
+// updateLastAppliedAnnotation stores the serialized service (without this annotation) as
+// last applied configuration on the service and returns the serialized service including
+// the annotation
+func updateLastAppliedAnnotation(service *servingv1.Service) ([]byte, error) {
+	delete(service.Annotations, corev1.LastAppliedConfigAnnotation)
+	if len(service.Annotations) == 0 {
+		service.Annotations = nil
+	}
+	lastApplied, err := json.Marshal(service)
+	if err != nil {
+		return nil, err
+	}
+	if service.Annotations == nil {
+		service.Annotations = map[string]string{}
+	}
+	service.Annotations[corev1.LastAppliedConfigAnnotation] = string(lastApplied)
+	return json.Marshal(service)
+}
@@ -0,0 +1,106 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestApplyServiceCreate(t *testing.T) {
+	serving, client := setup()
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.GetAction).GetName()
+			return true, nil, errors.NewNotFound(servingv1.Resource("service"), name)
+		})
+	serving.AddReactor("create", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			service := a.(clienttesting.CreateAction).GetObject().(*servingv1.Service)
+			assert.Assert(t, service.Annotations[corev1.LastAppliedConfigAnnotation] != "")
+			return true, service, nil
+		})
+
+	changed, err := client.ApplyService(newServiceWithImage("foo", "gcr.io/foo/bar:v1"))
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+}
+
+func TestApplyServiceUpdate(t *testing.T) {
+	serving, client := setup()
+
+	// Simulate a service which has been applied before and then defaulted by the server
+	current := newServiceWithImage("foo", "gcr.io/foo/bar:v1")
+	_, err := updateLastAppliedAnnotation(current)
+	assert.NilError(t, err)
+	current.SetDefaults(context.Background())
+	current.Status.LatestReadyRevisionName = "foo-00001"
+
+	var patches []string
+	serving.AddReactor("get", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, current.DeepCopy(), nil
+		})
+	serving.AddReactor("patch", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			patches = append(patches, string(a.(clienttesting.PatchAction).GetPatch()))
+			return true, current, nil
+		})
+
+	t.Run("applying the same service doesn't change it", func(t *testing.T) {
+		changed, err := client.ApplyService(newServiceWithImage("foo", "gcr.io/foo/bar:v1"))
+		assert.NilError(t, err)
+		assert.Assert(t, !changed)
+		assert.Equal(t, len(patches), 0)
+	})
+
+	t.Run("applying a new image patches the service", func(t *testing.T) {
+		changed, err := client.ApplyService(newServiceWithImage("foo", "gcr.io/foo/bar:v2"))
+		assert.NilError(t, err)
+		assert.Assert(t, changed)
+		assert.Equal(t, len(patches), 1)
+		assert.Assert(t, strings.Contains(patches[0], "gcr.io/foo/bar:v2"))
+		assert.Assert(t, !strings.Contains(patches[0], "latestReadyRevisionName"))
+	})
+
+	t.Run("removing an annotation applied before removes it", func(t *testing.T) {
+		patches = nil
+		withAnnotation := newServiceWithImage("foo", "gcr.io/foo/bar:v1")
+		withAnnotation.Annotations = map[string]string{"foo": "bar"}
+		_, err := updateLastAppliedAnnotation(withAnnotation)
+		assert.NilError(t, err)
+		withAnnotation.SetDefaults(context.Background())
+		current = withAnnotation
+
+		changed, err := client.ApplyService(newServiceWithImage("foo", "gcr.io/foo/bar:v1"))
+		assert.NilError(t, err)
+		assert.Assert(t, changed)
+		assert.Assert(t, strings.Contains(patches[0], `"foo":null`))
+	})
+}
+
+func newServiceWithImage(name string, image string) *servingv1.Service {
+	service := newService(name)
+	service.Spec.Template.Spec.Containers = []corev1.Container{{Image: image}}
+	return service
+}
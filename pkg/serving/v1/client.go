@@ -67,6 +67,10 @@ type KnServingClient interface {
 	// place.
 	UpdateServiceWithRetry(name string, updateFunc serviceUpdateFunc, nrRetries int) error
 
+	// ApplyService creates the given service if it doesn't exist or merges it with the
+	// existing service otherwise. Returns true if the service has been created or changed.
+	ApplyService(service *servingv1.Service) (bool, error)
+
 	// Delete a service by name
 	DeleteService(name string, timeout time.Duration) error
 
@@ -105,6 +105,16 @@ func (c *MockKnServingClient) UpdateServiceWithRetry(name string, updateFunc ser
 	return updateServiceWithRetry(c, name, updateFunc, maxRetry)
 }
 
+// Apply a service
+func (sr *ServingRecorder) ApplyService(service interface{}, changed bool, err error) {
+	sr.r.Add("ApplyService", []interface{}{service}, []interface{}{changed, err})
+}
+
+func (c *MockKnServingClient) ApplyService(service *servingv1.Service) (bool, error) {
+	call := c.recorder.r.VerifyCall("ApplyService", service)
+	return call.Result[0].(bool), mock.ErrorOrNil(call.Result[1])
+}
+
 // Delete a service by name
 func (sr *ServingRecorder) DeleteService(name, timeout interface{}, err error) {
 	sr.r.Add("DeleteService", []interface{}{name, timeout}, []interface{}{err})
@@ -0,0 +1,159 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	knerrors "knative.dev/client/pkg/errors"
+)
+
+// KnServingClient for interacting with the v1alpha1 serving resources. All
+// methods are relative to the namespace specified during construction
+type KnServingClient interface {
+
+	// Namespace in which this client is operating for
+	Namespace() string
+
+	// GetDomainMapping returns a DomainMapping by its name, i.e. its domain
+	GetDomainMapping(name string) (*DomainMapping, error)
+
+	// CreateDomainMapping creates a DomainMapping
+	CreateDomainMapping(domainMapping *DomainMapping) error
+
+	// DeleteDomainMapping deletes a DomainMapping by its name
+	DeleteDomainMapping(name string) error
+
+	// ListDomainMappings lists all DomainMappings. An empty list is returned
+	// if DomainMappings are not available in the cluster.
+	ListDomainMappings() (*DomainMappingList, error)
+}
+
+// knServingClient is a combination of the dynamic client and namespace
+type knServingClient struct {
+	client    dynamic.Interface
+	namespace string
+}
+
+// NewKnServingClient is to invoke the v1alpha1 serving API via the dynamic client
+func NewKnServingClient(client dynamic.Interface, namespace string) KnServingClient {
+	return &knServingClient{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// Namespace of this client
+func (c *knServingClient) Namespace() string {
+	return c.namespace
+}
+
+// GetDomainMapping returns a DomainMapping by its name
+func (c *knServingClient) GetDomainMapping(name string) (*DomainMapping, error) {
+	u, err := c.client.Resource(DomainMappingGVR).Namespace(c.namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	domainMapping := &DomainMapping{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), domainMapping); err != nil {
+		return nil, err
+	}
+	return domainMapping, nil
+}
+
+// CreateDomainMapping creates a DomainMapping
+func (c *knServingClient) CreateDomainMapping(domainMapping *DomainMapping) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(domainMapping)
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion(DomainMappingGVR.GroupVersion().String())
+	u.SetKind("DomainMapping")
+	_, err = c.client.Resource(DomainMappingGVR).Namespace(c.namespace).Create(u, metav1.CreateOptions{})
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// DeleteDomainMapping deletes a DomainMapping by its name
+func (c *knServingClient) DeleteDomainMapping(name string) error {
+	err := c.client.Resource(DomainMappingGVR).Namespace(c.namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// ListDomainMappings returns all DomainMappings of the namespace
+func (c *knServingClient) ListDomainMappings() (*DomainMappingList, error) {
+	uList, err := c.client.Resource(DomainMappingGVR).Namespace(c.namespace).List(metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		return &DomainMappingList{}, nil
+	}
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	domainMappingList := &DomainMappingList{Items: make([]DomainMapping, len(uList.Items))}
+	for i, u := range uList.Items {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &domainMappingList.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+	domainMappingList.APIVersion = DomainMappingGVR.GroupVersion().String()
+	domainMappingList.Kind = "DomainMappingList"
+	return domainMappingList, nil
+}
+
+// DomainMappingBuilder is for building the DomainMapping
+type DomainMappingBuilder struct {
+	domainMapping *DomainMapping
+}
+
+// NewDomainMappingBuilder for building a DomainMapping object for the given domain
+func NewDomainMappingBuilder(domain string) *DomainMappingBuilder {
+	return &DomainMappingBuilder{domainMapping: &DomainMapping{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: DomainMappingGVR.GroupVersion().String(),
+			Kind:       "DomainMapping",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: domain,
+		},
+	}}
+}
+
+// Namespace for this DomainMapping
+func (b *DomainMappingBuilder) Namespace(ns string) *DomainMappingBuilder {
+	b.domainMapping.Namespace = ns
+	return b
+}
+
+// Reference to the Addressable the domain is mapped to
+func (b *DomainMappingBuilder) Reference(ref duckv1.KReference) *DomainMappingBuilder {
+	b.domainMapping.Spec.Ref = ref
+	return b
+}
+
+// Build to return an instance of DomainMapping object
+func (b *DomainMappingBuilder) Build() *DomainMapping {
+	return b.domainMapping
+}
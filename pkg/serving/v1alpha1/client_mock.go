@@ -0,0 +1,106 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"knative.dev/client/pkg/util/mock"
+)
+
+// MockKnServingClient is a combine of test object and recorder
+type MockKnServingClient struct {
+	t        *testing.T
+	recorder *ServingRecorder
+}
+
+// NewMockKnServingClient returns a new mock instance which you need to record for
+func NewMockKnServingClient(t *testing.T, ns ...string) *MockKnServingClient {
+	namespace := "default"
+	if len(ns) > 0 {
+		namespace = ns[0]
+	}
+	return &MockKnServingClient{
+		t:        t,
+		recorder: &ServingRecorder{mock.NewRecorder(t, namespace)},
+	}
+}
+
+// Ensure that the interface is implemented
+var _ KnServingClient = &MockKnServingClient{}
+
+// ServingRecorder is recorder for v1alpha1 serving objects
+type ServingRecorder struct {
+	r *mock.Recorder
+}
+
+// Recorder returns the recorder for registering API calls
+func (c *MockKnServingClient) Recorder() *ServingRecorder {
+	return c.recorder
+}
+
+// Namespace of this client
+func (c *MockKnServingClient) Namespace() string {
+	return c.recorder.r.Namespace()
+}
+
+// GetDomainMapping records a call for GetDomainMapping with the expected object or error. Either domainMapping or err should be nil
+func (sr *ServingRecorder) GetDomainMapping(name interface{}, domainMapping *DomainMapping, err error) {
+	sr.r.Add("GetDomainMapping", []interface{}{name}, []interface{}{domainMapping, err})
+}
+
+// GetDomainMapping performs a previously recorded action, failing if non has been registered
+func (c *MockKnServingClient) GetDomainMapping(name string) (*DomainMapping, error) {
+	call := c.recorder.r.VerifyCall("GetDomainMapping", name)
+	return call.Result[0].(*DomainMapping), mock.ErrorOrNil(call.Result[1])
+}
+
+// CreateDomainMapping records a call for CreateDomainMapping with the expected error
+func (sr *ServingRecorder) CreateDomainMapping(domainMapping interface{}, err error) {
+	sr.r.Add("CreateDomainMapping", []interface{}{domainMapping}, []interface{}{err})
+}
+
+// CreateDomainMapping performs a previously recorded action, failing if non has been registered
+func (c *MockKnServingClient) CreateDomainMapping(domainMapping *DomainMapping) error {
+	call := c.recorder.r.VerifyCall("CreateDomainMapping", domainMapping)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// DeleteDomainMapping records a call for DeleteDomainMapping with the expected error (nil if none)
+func (sr *ServingRecorder) DeleteDomainMapping(name interface{}, err error) {
+	sr.r.Add("DeleteDomainMapping", []interface{}{name}, []interface{}{err})
+}
+
+// DeleteDomainMapping performs a previously recorded action, failing if non has been registered
+func (c *MockKnServingClient) DeleteDomainMapping(name string) error {
+	call := c.recorder.r.VerifyCall("DeleteDomainMapping", name)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// ListDomainMappings records a call for ListDomainMappings with the expected result and error (nil if none)
+func (sr *ServingRecorder) ListDomainMappings(domainMappingList *DomainMappingList, err error) {
+	sr.r.Add("ListDomainMappings", nil, []interface{}{domainMappingList, err})
+}
+
+// ListDomainMappings performs a previously recorded action, failing if non has been registered
+func (c *MockKnServingClient) ListDomainMappings() (*DomainMappingList, error) {
+	call := c.recorder.r.VerifyCall("ListDomainMappings")
+	return call.Result[0].(*DomainMappingList), mock.ErrorOrNil(call.Result[1])
+}
+
+// Validate validates whether every recorded action has been called
+func (sr *ServingRecorder) Validate() {
+	sr.r.CheckThatAllRecordedMethodsHaveBeenCalled()
+}
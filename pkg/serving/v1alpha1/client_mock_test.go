@@ -0,0 +1,40 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+)
+
+func TestMockKnServingClient(t *testing.T) {
+	client := NewMockKnServingClient(t)
+
+	recorder := client.Recorder()
+
+	// Record all calls
+	recorder.GetDomainMapping("example.com", nil, nil)
+	recorder.CreateDomainMapping(&DomainMapping{}, nil)
+	recorder.DeleteDomainMapping("example.com", nil)
+	recorder.ListDomainMappings(nil, nil)
+
+	// Call all calls
+	client.GetDomainMapping("example.com")
+	client.CreateDomainMapping(&DomainMapping{})
+	client.DeleteDomainMapping("example.com")
+	client.ListDomainMappings()
+
+	// Validate
+	recorder.Validate()
+}
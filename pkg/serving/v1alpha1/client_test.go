@@ -0,0 +1,127 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+var testNamespace = "test-ns"
+
+func setup(objects ...runtime.Object) (KnServingClient, *dynamicfake.FakeDynamicClient) {
+	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+	return NewKnServingClient(fakeDynamic, testNamespace), fakeDynamic
+}
+
+func TestNamespace(t *testing.T) {
+	client, _ := setup()
+	assert.Equal(t, client.Namespace(), testNamespace)
+}
+
+func TestGetDomainMapping(t *testing.T) {
+	client, _ := setup(newDomainMappingUnstructuredObj("foo.example.com", "foo"))
+
+	domainMapping, err := client.GetDomainMapping("foo.example.com")
+	assert.NilError(t, err)
+	assert.Equal(t, domainMapping.Name, "foo.example.com")
+	assert.Equal(t, domainMapping.Spec.Ref.Name, "foo")
+	assert.Equal(t, domainMapping.Status.URL.String(), "https://foo.example.com")
+
+	_, err = client.GetDomainMapping("bar.example.com")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestCreateDomainMapping(t *testing.T) {
+	client, _ := setup()
+
+	domainMapping := NewDomainMappingBuilder("foo.example.com").
+		Namespace(testNamespace).
+		Reference(duckv1.KReference{Kind: "Service", APIVersion: "serving.knative.dev/v1", Name: "foo"}).
+		Build()
+	assert.NilError(t, client.CreateDomainMapping(domainMapping))
+
+	created, err := client.GetDomainMapping("foo.example.com")
+	assert.NilError(t, err)
+	assert.Equal(t, created.Kind, "DomainMapping")
+	assert.Equal(t, created.Spec.Ref.Name, "foo")
+
+	err = client.CreateDomainMapping(domainMapping)
+	assert.ErrorContains(t, err, "already exists")
+}
+
+func TestDeleteDomainMapping(t *testing.T) {
+	client, _ := setup(newDomainMappingUnstructuredObj("foo.example.com", "foo"))
+
+	assert.NilError(t, client.DeleteDomainMapping("foo.example.com"))
+	err := client.DeleteDomainMapping("foo.example.com")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestListDomainMappings(t *testing.T) {
+	client, fakeDynamic := setup(
+		newDomainMappingUnstructuredObj("foo.example.com", "foo"),
+		newDomainMappingUnstructuredObj("bar.example.com", "bar"),
+	)
+
+	domainMappingList, err := client.ListDomainMappings()
+	assert.NilError(t, err)
+	assert.Equal(t, len(domainMappingList.Items), 2)
+	assert.Equal(t, domainMappingList.Kind, "DomainMappingList")
+
+	fakeDynamic.PrependReactor("list", "domainmappings", func(a clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(DomainMappingGVR.GroupResource(), "")
+	})
+	domainMappingList, err = client.ListDomainMappings()
+	assert.NilError(t, err)
+	assert.Equal(t, len(domainMappingList.Items), 0)
+
+	fakeDynamic.PrependReactor("list", "domainmappings", func(a clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("boom")
+	})
+	_, err = client.ListDomainMappings()
+	assert.ErrorContains(t, err, "boom")
+}
+
+func newDomainMappingUnstructuredObj(domain, service string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "serving.knative.dev/v1alpha1",
+			"kind":       "DomainMapping",
+			"metadata": map[string]interface{}{
+				"namespace": testNamespace,
+				"name":      domain,
+			},
+			"spec": map[string]interface{}{
+				"ref": map[string]interface{}{
+					"apiVersion": "serving.knative.dev/v1",
+					"kind":       "Service",
+					"name":       service,
+				},
+			},
+			"status": map[string]interface{}{
+				"url": "https://" + domain,
+			},
+		},
+	}
+}
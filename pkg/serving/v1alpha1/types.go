@@ -0,0 +1,121 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// The DomainMapping API is not part of the serving version this client is
+// built against, so the types are declared here and the client talks to the
+// cluster via the dynamic client.
+
+// DomainMappingGVR is the resource of DomainMappings
+var DomainMappingGVR = schema.GroupVersionResource{
+	Group:    "serving.knative.dev",
+	Version:  "v1alpha1",
+	Resource: "domainmappings",
+}
+
+// DomainMapping maps a custom domain, given as the name of the mapping, to an
+// Addressable like a Knative service
+type DomainMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainMappingSpec   `json:"spec,omitempty"`
+	Status DomainMappingStatus `json:"status,omitempty"`
+}
+
+// DomainMappingSpec is the desired state of a DomainMapping
+type DomainMappingSpec struct {
+	// Ref points to the Addressable the domain should be mapped to
+	Ref duckv1.KReference `json:"ref"`
+}
+
+// DomainMappingStatus is the observed state of a DomainMapping
+type DomainMappingStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// URL is the URL under which the mapped domain is reachable
+	URL *apis.URL `json:"url,omitempty"`
+
+	// Address holds the cluster internal address of the mapping
+	Address *duckv1.Addressable `json:"address,omitempty"`
+}
+
+// DomainMappingList is a list of DomainMappings
+type DomainMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []DomainMapping `json:"items"`
+}
+
+// DeepCopyInto copies the receiver into out
+func (in *DomainMapping) DeepCopyInto(out *DomainMapping) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.Status.DeepCopyInto(&out.Status.Status)
+	if in.Status.URL != nil {
+		out.Status.URL = in.Status.URL.DeepCopy()
+	}
+	if in.Status.Address != nil {
+		out.Status.Address = in.Status.Address.DeepCopy()
+	}
+}
+
+// DeepCopy creates a new deep copy of the DomainMapping
+func (in *DomainMapping) DeepCopy() *DomainMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject implements runtime.Object
+func (in *DomainMapping) DeepCopyObject() runtime.Object {
+	return in.DeepCopy()
+}
+
+// DeepCopy creates a new deep copy of the DomainMappingList
+func (in *DomainMappingList) DeepCopy() *DomainMappingList {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMappingList)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]DomainMapping, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+	return out
+}
+
+// DeepCopyObject implements runtime.Object
+func (in *DomainMappingList) DeepCopyObject() runtime.Object {
+	return in.DeepCopy()
+}
@@ -29,6 +29,9 @@ type KnSourcesClient interface {
 
 	// Get client for ApiServer sources
 	APIServerSourcesClient() KnAPIServerSourcesClient
+
+	// Get client for Container sources
+	ContainerSourcesClient() KnContainerSourcesClient
 }
 
 // sourcesClient is a combination of Sources client interface and namespace
@@ -61,3 +64,8 @@ func (c *sourcesClient) SinkBindingClient() KnSinkBindingClient {
 func (c *sourcesClient) APIServerSourcesClient() KnAPIServerSourcesClient {
 	return newKnAPIServerSourcesClient(c.client.ApiServerSources(c.namespace), c.namespace)
 }
+
+// ContainerSourcesClient for dealing with Container sources
+func (c *sourcesClient) ContainerSourcesClient() KnContainerSourcesClient {
+	return newKnContainerSourcesClient(c.client.ContainerSources(c.namespace), c.namespace)
+}
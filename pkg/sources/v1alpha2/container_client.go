@@ -0,0 +1,178 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+	clientv1alpha2 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	knerrors "knative.dev/client/pkg/errors"
+)
+
+// KnContainerSourcesClient interface for working with Container sources
+type KnContainerSourcesClient interface {
+
+	// GetContainerSource fetches a Container source by its name
+	GetContainerSource(name string) (*v1alpha2.ContainerSource, error)
+
+	// CreateContainerSource creates a Container source
+	CreateContainerSource(containerSource *v1alpha2.ContainerSource) error
+
+	// UpdateContainerSource updates a Container source
+	UpdateContainerSource(containerSource *v1alpha2.ContainerSource) error
+
+	// DeleteContainerSource deletes a Container source
+	DeleteContainerSource(name string) error
+
+	// ListContainerSource lists all Container sources
+	ListContainerSource() (*v1alpha2.ContainerSourceList, error)
+
+	// Get namespace for this client
+	Namespace() string
+}
+
+// containerSourcesClient is a combination of the Container source client interface and namespace
+type containerSourcesClient struct {
+	client    clientv1alpha2.ContainerSourceInterface
+	namespace string
+}
+
+// newKnContainerSourcesClient is to invoke Eventing Sources Client API to create object
+func newKnContainerSourcesClient(client clientv1alpha2.ContainerSourceInterface, namespace string) KnContainerSourcesClient {
+	return &containerSourcesClient{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// Return the client's namespace
+func (c *containerSourcesClient) Namespace() string {
+	return c.namespace
+}
+
+// GetContainerSource returns the Container source with the given name
+func (c *containerSourcesClient) GetContainerSource(name string) (*v1alpha2.ContainerSource, error) {
+	source, err := c.client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	err = updateSourceGVK(source)
+	if err != nil {
+		return nil, err
+	}
+	return source, nil
+}
+
+// CreateContainerSource is used to create an instance of ContainerSource
+func (c *containerSourcesClient) CreateContainerSource(containerSource *v1alpha2.ContainerSource) error {
+	if containerSource.Spec.Sink.Ref == nil && containerSource.Spec.Sink.URI == nil {
+		return fmt.Errorf("a sink is required for creating a source")
+	}
+	_, err := c.client.Create(containerSource)
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// UpdateContainerSource is used to update an instance of ContainerSource
+func (c *containerSourcesClient) UpdateContainerSource(containerSource *v1alpha2.ContainerSource) error {
+	_, err := c.client.Update(containerSource)
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// DeleteContainerSource is used to delete an instance of ContainerSource
+func (c *containerSourcesClient) DeleteContainerSource(name string) error {
+	err := c.client.Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return knerrors.GetError(err)
+	}
+	return nil
+}
+
+// ListContainerSource returns the available Container sources
+func (c *containerSourcesClient) ListContainerSource() (*v1alpha2.ContainerSourceList, error) {
+	sourceList, err := c.client.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+
+	sourceListNew := sourceList.DeepCopy()
+	err = updateSourceGVK(sourceListNew)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceListNew.Items = make([]v1alpha2.ContainerSource, len(sourceList.Items))
+	for idx, source := range sourceList.Items {
+		sourceClone := source.DeepCopy()
+		err := updateSourceGVK(sourceClone)
+		if err != nil {
+			return nil, err
+		}
+		sourceListNew.Items[idx] = *sourceClone
+	}
+	return sourceListNew, nil
+}
+
+// ContainerSourceBuilder is for building the source
+type ContainerSourceBuilder struct {
+	containerSource *v1alpha2.ContainerSource
+}
+
+// NewContainerSourceBuilder for building Container source object
+func NewContainerSourceBuilder(name string) *ContainerSourceBuilder {
+	return &ContainerSourceBuilder{containerSource: &v1alpha2.ContainerSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}}
+}
+
+// NewContainerSourceBuilderFromExisting creates a builder from an existing Container source
+func NewContainerSourceBuilderFromExisting(containerSource *v1alpha2.ContainerSource) *ContainerSourceBuilder {
+	return &ContainerSourceBuilder{containerSource: containerSource.DeepCopy()}
+}
+
+// Namespace for this source
+func (b *ContainerSourceBuilder) Namespace(ns string) *ContainerSourceBuilder {
+	b.containerSource.Namespace = ns
+	return b
+}
+
+// PodSpec defines the pod spec of the pod template running the container(s)
+func (b *ContainerSourceBuilder) PodSpec(podSpec corev1.PodSpec) *ContainerSourceBuilder {
+	b.containerSource.Spec.Template.Spec = podSpec
+	return b
+}
+
+// Sink or destination of the source
+func (b *ContainerSourceBuilder) Sink(sink duckv1.Destination) *ContainerSourceBuilder {
+	b.containerSource.Spec.Sink = sink
+	return b
+}
+
+// Build the ContainerSource object
+func (b *ContainerSourceBuilder) Build() *v1alpha2.ContainerSource {
+	return b.containerSource
+}
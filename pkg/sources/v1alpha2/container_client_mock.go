@@ -0,0 +1,119 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha2
+
+import (
+	"testing"
+
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+
+	"knative.dev/client/pkg/util/mock"
+)
+
+// MockKnContainerSourceClient for mocking the client
+type MockKnContainerSourceClient struct {
+	t        *testing.T
+	recorder *ContainerSourcesRecorder
+}
+
+// NewMockKnContainerSourceClient returns a new mock instance which you need to record for
+func NewMockKnContainerSourceClient(t *testing.T, ns ...string) *MockKnContainerSourceClient {
+	namespace := "default"
+	if len(ns) > 0 {
+		namespace = ns[0]
+	}
+	return &MockKnContainerSourceClient{
+		t:        t,
+		recorder: &ContainerSourcesRecorder{mock.NewRecorder(t, namespace)},
+	}
+}
+
+// Ensure that the interface is implemented
+var _ KnContainerSourcesClient = &MockKnContainerSourceClient{}
+
+// ContainerSourcesRecorder for recording actions on source
+type ContainerSourcesRecorder struct {
+	r *mock.Recorder
+}
+
+// Recorder returns the recorder for registering API calls
+func (c *MockKnContainerSourceClient) Recorder() *ContainerSourcesRecorder {
+	return c.recorder
+}
+
+// Namespace of this client
+func (c *MockKnContainerSourceClient) Namespace() string {
+	return c.recorder.r.Namespace()
+}
+
+// GetContainerSource records a call for GetContainerSource with the expected object or error. Either containerSource or err should be nil
+func (sr *ContainerSourcesRecorder) GetContainerSource(name interface{}, containerSource *v1alpha2.ContainerSource, err error) {
+	sr.r.Add("GetContainerSource", []interface{}{name}, []interface{}{containerSource, err})
+}
+
+// GetContainerSource performs a previously recorded action, failing if non has been registered
+func (c *MockKnContainerSourceClient) GetContainerSource(name string) (*v1alpha2.ContainerSource, error) {
+	call := c.recorder.r.VerifyCall("GetContainerSource", name)
+	return call.Result[0].(*v1alpha2.ContainerSource), mock.ErrorOrNil(call.Result[1])
+}
+
+// CreateContainerSource records a call for CreateContainerSource with the expected error
+func (sr *ContainerSourcesRecorder) CreateContainerSource(containerSource interface{}, err error) {
+	sr.r.Add("CreateContainerSource", []interface{}{containerSource}, []interface{}{err})
+}
+
+// CreateContainerSource performs a previously recorded action, failing if non has been registered
+func (c *MockKnContainerSourceClient) CreateContainerSource(containerSource *v1alpha2.ContainerSource) error {
+	call := c.recorder.r.VerifyCall("CreateContainerSource", containerSource)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// UpdateContainerSource records a call for UpdateContainerSource with the expected error (nil if none)
+func (sr *ContainerSourcesRecorder) UpdateContainerSource(containerSource interface{}, err error) {
+	sr.r.Add("UpdateContainerSource", []interface{}{containerSource}, []interface{}{err})
+}
+
+// UpdateContainerSource performs a previously recorded action, failing if non has been registered
+func (c *MockKnContainerSourceClient) UpdateContainerSource(containerSource *v1alpha2.ContainerSource) error {
+	call := c.recorder.r.VerifyCall("UpdateContainerSource", containerSource)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// DeleteContainerSource records a call for DeleteContainerSource with the expected error (nil if none)
+func (sr *ContainerSourcesRecorder) DeleteContainerSource(name interface{}, err error) {
+	sr.r.Add("DeleteContainerSource", []interface{}{name}, []interface{}{err})
+}
+
+// DeleteContainerSource performs a previously recorded action, failing if non has been registered
+func (c *MockKnContainerSourceClient) DeleteContainerSource(name string) error {
+	call := c.recorder.r.VerifyCall("DeleteContainerSource", name)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// ListContainerSource records a call for ListContainerSource with the expected error (nil if none)
+func (sr *ContainerSourcesRecorder) ListContainerSource(containerSourceList *v1alpha2.ContainerSourceList, err error) {
+	sr.r.Add("ListContainerSource", []interface{}{}, []interface{}{containerSourceList, err})
+}
+
+// ListContainerSource performs a previously recorded action, failing if non has been registered
+func (c *MockKnContainerSourceClient) ListContainerSource() (*v1alpha2.ContainerSourceList, error) {
+	call := c.recorder.r.VerifyCall("ListContainerSource")
+	return call.Result[0].(*v1alpha2.ContainerSourceList), mock.ErrorOrNil(call.Result[1])
+}
+
+// Validate validates whether every recorded action has been called
+func (sr *ContainerSourcesRecorder) Validate() {
+	sr.r.CheckThatAllRecordedMethodsHaveBeenCalled()
+}
@@ -0,0 +1,148 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha2
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+	fake "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2/fake"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+var testContainerSourceNamespace = "test-ns"
+
+func setupContainerSourcesClient(t *testing.T) (fakeSources fake.FakeSourcesV1alpha2, client KnContainerSourcesClient) {
+	fakeSources = fake.FakeSourcesV1alpha2{Fake: &clienttesting.Fake{}}
+	client = NewKnSourcesClient(&fakeSources, testContainerSourceNamespace).ContainerSourcesClient()
+	assert.Equal(t, client.Namespace(), testContainerSourceNamespace)
+	return
+}
+
+func TestCreateContainerSource(t *testing.T) {
+	sourcesServer, client := setupContainerSourcesClient(t)
+
+	sourcesServer.AddReactor("create", "containersources",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			newSource := a.(clienttesting.CreateAction).GetObject()
+			name := newSource.(metav1.Object).GetName()
+			if name == "errorSource" {
+				return true, nil, fmt.Errorf("error while creating Container source %s", name)
+			}
+			return true, newSource, nil
+		})
+
+	err := client.CreateContainerSource(newContainerSource("foo"))
+	assert.NilError(t, err)
+
+	err = client.CreateContainerSource(newContainerSource("errorSource"))
+	assert.ErrorContains(t, err, "errorSource")
+
+	err = client.CreateContainerSource(NewContainerSourceBuilder("nosink").Build())
+	assert.ErrorContains(t, err, "sink")
+}
+
+func TestGetContainerSource(t *testing.T) {
+	sourcesServer, client := setupContainerSourcesClient(t)
+
+	sourcesServer.AddReactor("get", "containersources",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.GetAction).GetName()
+			if name == "errorSource" {
+				return true, nil, fmt.Errorf("error while getting Container source %s", name)
+			}
+			return true, newContainerSource(name), nil
+		})
+
+	source, err := client.GetContainerSource("foo")
+	assert.NilError(t, err)
+	assert.Equal(t, source.Name, "foo")
+	assert.Equal(t, source.Kind, "ContainerSource")
+
+	_, err = client.GetContainerSource("errorSource")
+	assert.ErrorContains(t, err, "errorSource")
+}
+
+func TestUpdateContainerSource(t *testing.T) {
+	sourcesServer, client := setupContainerSourcesClient(t)
+
+	sourcesServer.AddReactor("update", "containersources",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			updatedSource := a.(clienttesting.UpdateAction).GetObject()
+			name := updatedSource.(metav1.Object).GetName()
+			if name == "errorSource" {
+				return true, nil, fmt.Errorf("error while updating Container source %s", name)
+			}
+			return true, updatedSource, nil
+		})
+
+	err := client.UpdateContainerSource(newContainerSource("foo"))
+	assert.NilError(t, err)
+
+	err = client.UpdateContainerSource(newContainerSource("errorSource"))
+	assert.ErrorContains(t, err, "errorSource")
+}
+
+func TestDeleteContainerSource(t *testing.T) {
+	sourcesServer, client := setupContainerSourcesClient(t)
+
+	sourcesServer.AddReactor("delete", "containersources",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.DeleteAction).GetName()
+			if name == "errorSource" {
+				return true, nil, fmt.Errorf("error while deleting Container source %s", name)
+			}
+			return true, nil, nil
+		})
+
+	err := client.DeleteContainerSource("foo")
+	assert.NilError(t, err)
+
+	err = client.DeleteContainerSource("errorSource")
+	assert.ErrorContains(t, err, "errorSource")
+}
+
+func TestListContainerSource(t *testing.T) {
+	sourcesServer, client := setupContainerSourcesClient(t)
+
+	sourcesServer.AddReactor("list", "containersources",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &v1alpha2.ContainerSourceList{Items: []v1alpha2.ContainerSource{*newContainerSource("foo"), *newContainerSource("bar")}}, nil
+		})
+
+	sourceList, err := client.ListContainerSource()
+	assert.NilError(t, err)
+	assert.Equal(t, sourceList.Kind, "ContainerSourceList")
+	assert.Equal(t, len(sourceList.Items), 2)
+	assert.Equal(t, sourceList.Items[0].Name, "foo")
+	assert.Equal(t, sourceList.Items[1].Kind, "ContainerSource")
+}
+
+func newContainerSource(name string) *v1alpha2.ContainerSource {
+	sink := duckv1.Destination{
+		Ref: &duckv1.KReference{Kind: "Service", Name: "foosvc", APIVersion: "serving.knative.dev/v1", Namespace: "default"},
+	}
+	return NewContainerSourceBuilder(name).
+		Namespace(testContainerSourceNamespace).
+		PodSpec(corev1.PodSpec{Containers: []corev1.Container{{Image: "docker.io/test/testimg"}}}).
+		Sink(sink).
+		Build()
+}
@@ -26,6 +26,20 @@ type CommandGroup struct {
 
 	// List of commands for this group
 	Commands []*cobra.Command
+
+	// CommandsFunc returns additional entries which are only shown in help
+	// messages but not added as commands (like plugins). It is evaluated
+	// lazily when the help message is rendered.
+	CommandsFunc func() []*cobra.Command
+}
+
+// AllCommands returns the commands of the group including the ones returned
+// by CommandsFunc
+func (g CommandGroup) AllCommands() []*cobra.Command {
+	if g.CommandsFunc == nil {
+		return g.Commands
+	}
+	return append(append([]*cobra.Command{}, g.Commands...), g.CommandsFunc()...)
 }
 
 type CommandGroups []CommandGroup
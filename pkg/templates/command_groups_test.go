@@ -27,12 +27,12 @@ import (
 
 var groups = CommandGroups{
 	{
-		"header-1",
-		[]*cobra.Command{{Use: "c0"}, {Use: "c1"}},
+		Header:   "header-1",
+		Commands: []*cobra.Command{{Use: "c0"}, {Use: "c1"}},
 	},
 	{
-		"header-2",
-		[]*cobra.Command{{Use: "c2"}},
+		Header:   "header-2",
+		Commands: []*cobra.Command{{Use: "c2"}},
 	},
 }
 
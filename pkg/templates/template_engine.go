@@ -90,9 +90,21 @@ func (e templateEngine) templateFunctions() template.FuncMap {
 }
 
 func (e templateEngine) cmdGroupsString() string {
-	var groups []string
+	var cmdGroups []CommandGroup
+	padding := 0
 	for _, cmdGroup := range e.CommandGroups {
-		groups = append(groups, formatCommandGroup(cmdGroup))
+		cmds := cmdGroup.AllCommands()
+		if len(cmds) == 0 {
+			continue
+		}
+		cmdGroups = append(cmdGroups, CommandGroup{Header: cmdGroup.Header, Commands: cmds})
+		if p := namePadding(cmds); p > padding {
+			padding = p
+		}
+	}
+	var groups []string
+	for _, cmdGroup := range cmdGroups {
+		groups = append(groups, formatCommandGroup(cmdGroup, padding))
 	}
 	return strings.Join(groups, "\n\n")
 }
@@ -101,7 +113,7 @@ func (e templateEngine) subCommandsString(c *cobra.Command) string {
 	return formatCommandGroup(CommandGroup{
 		Header:   "Available Commands:",
 		Commands: c.Commands(),
-	})
+	}, namePadding(c.Commands()))
 }
 
 func (e templateEngine) rootCmdName() string {
@@ -138,16 +150,30 @@ func useLine(c *cobra.Command) string {
 	return useline
 }
 
-func formatCommandGroup(cmdGroup CommandGroup) string {
+func formatCommandGroup(cmdGroup CommandGroup, padding int) string {
 	cmds := []string{cmdGroup.Header}
 	for _, cmd := range cmdGroup.Commands {
 		if cmd.IsAvailableCommand() {
-			cmds = append(cmds, "  "+rpad(cmd.Name(), cmd.NamePadding())+" "+cmd.Short)
+			cmds = append(cmds, "  "+rpad(cmd.Name(), padding)+" "+cmd.Short)
 		}
 	}
 	return strings.Join(cmds, "\n")
 }
 
+// namePadding returns the width needed for aligning the names of the given commands
+func namePadding(cmds []*cobra.Command) int {
+	padding := 0
+	for _, cmd := range cmds {
+		if p := cmd.NamePadding(); p > padding {
+			padding = p
+		}
+		if l := len(cmd.Name()); l > padding {
+			padding = l
+		}
+	}
+	return padding
+}
+
 func rpad(s string, padding int) string {
 	t := fmt.Sprintf("%%-%ds", padding)
 	return fmt.Sprintf(t, s)
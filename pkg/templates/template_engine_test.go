@@ -100,6 +100,31 @@ func TestHelpFunc(t *testing.T) {
 	}
 }
 
+func TestCommandGroupsWithCommandsFunc(t *testing.T) {
+	rootCmd, engine := newTemplateEngine()
+	called := false
+	engine.CommandGroups = append(engine.CommandGroups,
+		CommandGroup{
+			Header: "header-3",
+			CommandsFunc: func() []*cobra.Command {
+				called = true
+				return []*cobra.Command{newCmd("g3.1-long-name")}
+			},
+		},
+		CommandGroup{
+			Header:       "header-empty",
+			CommandsFunc: func() []*cobra.Command { return nil },
+		})
+	assert.Assert(t, !called)
+
+	out := engine.cmdGroupsString()
+	assert.Assert(t, called)
+	assert.Assert(t, util.ContainsAll(out, "header-1", "header-3", "g3.1-long-name desc-g3.1-long-name"))
+	assert.Assert(t, util.ContainsAll(out, "  g1.1           desc-g1.1"))
+	assert.Assert(t, util.ContainsNone(out, "header-empty"))
+	assert.Equal(t, len(rootCmd.Commands()), 5)
+}
+
 func TestOptionsFunc(t *testing.T) {
 	rootCmd, _ := newTemplateEngine()
 	subCmd := rootCmd.Commands()[0]
@@ -140,12 +165,12 @@ func newTemplateEngine() (*cobra.Command, templateEngine) {
 	rootCmd.PersistentFlags().String("global-opt", "", "global option")
 	cmdGroups := CommandGroups{
 		{
-			"header-1",
-			[]*cobra.Command{newCmd("g1.1"), newCmd("g1.2")},
+			Header:   "header-1",
+			Commands: []*cobra.Command{newCmd("g1.1"), newCmd("g1.2")},
 		},
 		{
-			"header-2",
-			[]*cobra.Command{newCmd("g2.1"), newCmd("g2.2"), newCmd("g2.3")},
+			Header:   "header-2",
+			Commands: []*cobra.Command{newCmd("g2.1"), newCmd("g2.2"), newCmd("g2.3")},
 		},
 	}
 	engine := templateEngine{
@@ -39,5 +39,5 @@ func TestVersion(t *testing.T) {
 
 	out := r.KnTest().Kn().RunNoNamespace("version")
 	r.AssertNoError(out)
-	assert.Check(t, util.ContainsAll(out.Stdout, "Version"))
+	assert.Check(t, util.ContainsAll(out.Stdout, "Version", "Server", "serving.knative.dev/v1"))
 }